@@ -70,6 +70,28 @@ func (m *CommentListRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetStartTime() < 0 {
+		err := CommentListRequestValidationError{
+			field:  "StartTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEndTime() < 0 {
+		err := CommentListRequestValidationError{
+			field:  "EndTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CommentListRequestMultiError(errors)
 	}
@@ -338,6 +360,17 @@ func (m *CommentActionRequest) validate(all bool) error {
 
 	// no validation rules for CommentId
 
+	if len(m.GetImageData()) > 5242880 {
+		err := CommentActionRequestValidationError{
+			field:  "ImageData",
+			reason: "value length must be at most 5242880 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CommentActionRequestMultiError(errors)
 	}
@@ -609,6 +642,46 @@ func (m *Comment) validate(all bool) error {
 
 	// no validation rules for CreateDate
 
+	// no validation rules for IsEdited
+
+	// no validation rules for ImageUrl
+
+	// no validation rules for ThumbnailUrl
+
+	for idx, item := range m.GetReactions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CommentValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CommentValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CommentValidationError{
+					field:  fmt.Sprintf("Reactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CommentMultiError(errors)
 	}
@@ -686,6 +759,354 @@ var _ interface {
 	ErrorName() string
 } = CommentValidationError{}
 
+// Validate checks the field values on CommentReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommentReactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommentReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommentReactionRequestMultiError, or nil if none found.
+func (m *CommentReactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommentReactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := CommentReactionRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCommentId() <= 0 {
+		err := CommentReactionRequestValidationError{
+			field:  "CommentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ActionType
+
+	if l := utf8.RuneCountInString(m.GetEmoji()); l < 1 || l > 8 {
+		err := CommentReactionRequestValidationError{
+			field:  "Emoji",
+			reason: "value length must be between 1 and 8 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CommentReactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommentReactionRequestMultiError is an error wrapping multiple validation
+// errors returned by CommentReactionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CommentReactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommentReactionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommentReactionRequestMultiError) AllErrors() []error { return m }
+
+// CommentReactionRequestValidationError is the validation error returned by
+// CommentReactionRequest.Validate if the designated constraints aren't met.
+type CommentReactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommentReactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommentReactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommentReactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommentReactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommentReactionRequestValidationError) ErrorName() string {
+	return "CommentReactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommentReactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommentReactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommentReactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommentReactionRequestValidationError{}
+
+// Validate checks the field values on CommentReactionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommentReactionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommentReactionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommentReactionReplyMultiError, or nil if none found.
+func (m *CommentReactionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommentReactionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	if len(errors) > 0 {
+		return CommentReactionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommentReactionReplyMultiError is an error wrapping multiple validation
+// errors returned by CommentReactionReply.ValidateAll() if the designated
+// constraints aren't met.
+type CommentReactionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommentReactionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommentReactionReplyMultiError) AllErrors() []error { return m }
+
+// CommentReactionReplyValidationError is the validation error returned by
+// CommentReactionReply.Validate if the designated constraints aren't met.
+type CommentReactionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommentReactionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommentReactionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommentReactionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommentReactionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommentReactionReplyValidationError) ErrorName() string {
+	return "CommentReactionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommentReactionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommentReactionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommentReactionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommentReactionReplyValidationError{}
+
+// Validate checks the field values on Reaction with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Reaction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Reaction with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReactionMultiError, or nil
+// if none found.
+func (m *Reaction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Reaction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Emoji
+
+	// no validation rules for Count
+
+	// no validation rules for IsReacted
+
+	if len(errors) > 0 {
+		return ReactionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReactionMultiError is an error wrapping multiple validation errors returned
+// by Reaction.ValidateAll() if the designated constraints aren't met.
+type ReactionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReactionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReactionMultiError) AllErrors() []error { return m }
+
+// ReactionValidationError is the validation error returned by
+// Reaction.Validate if the designated constraints aren't met.
+type ReactionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReactionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReactionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReactionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReactionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReactionValidationError) ErrorName() string { return "ReactionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReactionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReaction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReactionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReactionValidationError{}
+
 // Validate checks the field values on User with the rules defined in the proto
 // definition for this message. If any rules are violated, the first error
 // encountered is returned, or nil if there are no violations.
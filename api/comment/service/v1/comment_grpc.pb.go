@@ -20,8 +20,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	CommentService_GetCommentList_FullMethodName = "/comment.service.v1.CommentService/GetCommentList"
-	CommentService_CommentAction_FullMethodName  = "/comment.service.v1.CommentService/CommentAction"
+	CommentService_GetCommentList_FullMethodName  = "/comment.service.v1.CommentService/GetCommentList"
+	CommentService_CommentAction_FullMethodName   = "/comment.service.v1.CommentService/CommentAction"
+	CommentService_CommentReaction_FullMethodName = "/comment.service.v1.CommentService/CommentReaction"
 )
 
 // CommentServiceClient is the client API for CommentService service.
@@ -30,8 +31,10 @@ const (
 type CommentServiceClient interface {
 	// GetCommentList 获取评论列表
 	GetCommentList(ctx context.Context, in *CommentListRequest, opts ...grpc.CallOption) (*CommentListReply, error)
-	// CommentAction 发布评论或者删除评论
+	// CommentAction 发布评论、删除评论或者编辑评论
 	CommentAction(ctx context.Context, in *CommentActionRequest, opts ...grpc.CallOption) (*CommentActionReply, error)
+	// CommentReaction 对评论添加或者取消表情回应
+	CommentReaction(ctx context.Context, in *CommentReactionRequest, opts ...grpc.CallOption) (*CommentReactionReply, error)
 }
 
 type commentServiceClient struct {
@@ -60,14 +63,25 @@ func (c *commentServiceClient) CommentAction(ctx context.Context, in *CommentAct
 	return out, nil
 }
 
+func (c *commentServiceClient) CommentReaction(ctx context.Context, in *CommentReactionRequest, opts ...grpc.CallOption) (*CommentReactionReply, error) {
+	out := new(CommentReactionReply)
+	err := c.cc.Invoke(ctx, CommentService_CommentReaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommentServiceServer is the server API for CommentService service.
 // All implementations must embed UnimplementedCommentServiceServer
 // for forward compatibility
 type CommentServiceServer interface {
 	// GetCommentList 获取评论列表
 	GetCommentList(context.Context, *CommentListRequest) (*CommentListReply, error)
-	// CommentAction 发布评论或者删除评论
+	// CommentAction 发布评论、删除评论或者编辑评论
 	CommentAction(context.Context, *CommentActionRequest) (*CommentActionReply, error)
+	// CommentReaction 对评论添加或者取消表情回应
+	CommentReaction(context.Context, *CommentReactionRequest) (*CommentReactionReply, error)
 	mustEmbedUnimplementedCommentServiceServer()
 }
 
@@ -81,6 +95,9 @@ func (UnimplementedCommentServiceServer) GetCommentList(context.Context, *Commen
 func (UnimplementedCommentServiceServer) CommentAction(context.Context, *CommentActionRequest) (*CommentActionReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommentAction not implemented")
 }
+func (UnimplementedCommentServiceServer) CommentReaction(context.Context, *CommentReactionRequest) (*CommentReactionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommentReaction not implemented")
+}
 func (UnimplementedCommentServiceServer) mustEmbedUnimplementedCommentServiceServer() {}
 
 // UnsafeCommentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -130,6 +147,24 @@ func _CommentService_CommentAction_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommentService_CommentReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).CommentReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_CommentReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).CommentReaction(ctx, req.(*CommentReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CommentService_ServiceDesc is the grpc.ServiceDesc for CommentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -145,6 +180,10 @@ var CommentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CommentAction",
 			Handler:    _CommentService_CommentAction_Handler,
 		},
+		{
+			MethodName: "CommentReaction",
+			Handler:    _CommentService_CommentReaction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "comment/service/v1/comment.proto",
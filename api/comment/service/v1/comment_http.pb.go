@@ -21,11 +21,14 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationCommentServiceCommentAction = "/comment.service.v1.CommentService/CommentAction"
+const OperationCommentServiceCommentReaction = "/comment.service.v1.CommentService/CommentReaction"
 const OperationCommentServiceGetCommentList = "/comment.service.v1.CommentService/GetCommentList"
 
 type CommentServiceHTTPServer interface {
-	// CommentAction CommentAction 发布评论或者删除评论
+	// CommentAction CommentAction 发布评论、删除评论或者编辑评论
 	CommentAction(context.Context, *CommentActionRequest) (*CommentActionReply, error)
+	// CommentReaction CommentReaction 对评论添加或者取消表情回应
+	CommentReaction(context.Context, *CommentReactionRequest) (*CommentReactionReply, error)
 	// GetCommentList GetCommentList 获取评论列表
 	GetCommentList(context.Context, *CommentListRequest) (*CommentListReply, error)
 }
@@ -34,6 +37,7 @@ func RegisterCommentServiceHTTPServer(s *http.Server, srv CommentServiceHTTPServ
 	r := s.Route("/")
 	r.GET("/douyin/comment/list", _CommentService_GetCommentList0_HTTP_Handler(srv))
 	r.POST("/douyin/comment/action", _CommentService_CommentAction0_HTTP_Handler(srv))
+	r.POST("/douyin/comment/reaction", _CommentService_CommentReaction0_HTTP_Handler(srv))
 }
 
 func _CommentService_GetCommentList0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
@@ -77,8 +81,31 @@ func _CommentService_CommentAction0_HTTP_Handler(srv CommentServiceHTTPServer) f
 	}
 }
 
+func _CommentService_CommentReaction0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CommentReactionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceCommentReaction)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CommentReaction(ctx, req.(*CommentReactionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CommentReactionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type CommentServiceHTTPClient interface {
 	CommentAction(ctx context.Context, req *CommentActionRequest, opts ...http.CallOption) (rsp *CommentActionReply, err error)
+	CommentReaction(ctx context.Context, req *CommentReactionRequest, opts ...http.CallOption) (rsp *CommentReactionReply, err error)
 	GetCommentList(ctx context.Context, req *CommentListRequest, opts ...http.CallOption) (rsp *CommentListReply, err error)
 }
 
@@ -103,6 +130,19 @@ func (c *CommentServiceHTTPClientImpl) CommentAction(ctx context.Context, in *Co
 	return &out, err
 }
 
+func (c *CommentServiceHTTPClientImpl) CommentReaction(ctx context.Context, in *CommentReactionRequest, opts ...http.CallOption) (*CommentReactionReply, error) {
+	var out CommentReactionReply
+	pattern := "/douyin/comment/reaction"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCommentServiceCommentReaction))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *CommentServiceHTTPClientImpl) GetCommentList(ctx context.Context, in *CommentListRequest, opts ...http.CallOption) (*CommentListReply, error) {
 	var out CommentListReply
 	pattern := "/douyin/comment/list"
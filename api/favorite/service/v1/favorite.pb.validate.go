@@ -717,6 +717,1125 @@ var _ interface {
 	ErrorName() string
 } = FavoriteActionReplyValidationError{}
 
+// Validate checks the field values on CollectionActionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CollectionActionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CollectionActionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CollectionActionRequestMultiError, or nil if none found.
+func (m *CollectionActionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CollectionActionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := CollectionActionRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ActionType
+
+	// no validation rules for CollectionId
+
+	if utf8.RuneCountInString(m.GetName()) > 32 {
+		err := CollectionActionRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IsPrivate
+
+	if len(errors) > 0 {
+		return CollectionActionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectionActionRequestMultiError is an error wrapping multiple validation
+// errors returned by CollectionActionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CollectionActionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectionActionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectionActionRequestMultiError) AllErrors() []error { return m }
+
+// CollectionActionRequestValidationError is the validation error returned by
+// CollectionActionRequest.Validate if the designated constraints aren't met.
+type CollectionActionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectionActionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectionActionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectionActionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectionActionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectionActionRequestValidationError) ErrorName() string {
+	return "CollectionActionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CollectionActionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollectionActionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectionActionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectionActionRequestValidationError{}
+
+// Validate checks the field values on CollectionActionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CollectionActionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CollectionActionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CollectionActionReplyMultiError, or nil if none found.
+func (m *CollectionActionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CollectionActionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	if all {
+		switch v := interface{}(m.GetCollection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectionActionReplyValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectionActionReplyValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCollection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectionActionReplyValidationError{
+				field:  "Collection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CollectionActionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectionActionReplyMultiError is an error wrapping multiple validation
+// errors returned by CollectionActionReply.ValidateAll() if the designated
+// constraints aren't met.
+type CollectionActionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectionActionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectionActionReplyMultiError) AllErrors() []error { return m }
+
+// CollectionActionReplyValidationError is the validation error returned by
+// CollectionActionReply.Validate if the designated constraints aren't met.
+type CollectionActionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectionActionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectionActionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectionActionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectionActionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectionActionReplyValidationError) ErrorName() string {
+	return "CollectionActionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CollectionActionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollectionActionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectionActionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectionActionReplyValidationError{}
+
+// Validate checks the field values on CollectionListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CollectionListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CollectionListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CollectionListRequestMultiError, or nil if none found.
+func (m *CollectionListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CollectionListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return CollectionListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectionListRequestMultiError is an error wrapping multiple validation
+// errors returned by CollectionListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CollectionListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectionListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectionListRequestMultiError) AllErrors() []error { return m }
+
+// CollectionListRequestValidationError is the validation error returned by
+// CollectionListRequest.Validate if the designated constraints aren't met.
+type CollectionListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectionListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectionListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectionListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectionListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectionListRequestValidationError) ErrorName() string {
+	return "CollectionListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CollectionListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollectionListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectionListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectionListRequestValidationError{}
+
+// Validate checks the field values on CollectionListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CollectionListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CollectionListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CollectionListReplyMultiError, or nil if none found.
+func (m *CollectionListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CollectionListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	for idx, item := range m.GetCollectionList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CollectionListReplyValidationError{
+						field:  fmt.Sprintf("CollectionList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CollectionListReplyValidationError{
+						field:  fmt.Sprintf("CollectionList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CollectionListReplyValidationError{
+					field:  fmt.Sprintf("CollectionList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CollectionListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectionListReplyMultiError is an error wrapping multiple validation
+// errors returned by CollectionListReply.ValidateAll() if the designated
+// constraints aren't met.
+type CollectionListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectionListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectionListReplyMultiError) AllErrors() []error { return m }
+
+// CollectionListReplyValidationError is the validation error returned by
+// CollectionListReply.Validate if the designated constraints aren't met.
+type CollectionListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectionListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectionListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectionListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectionListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectionListReplyValidationError) ErrorName() string {
+	return "CollectionListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CollectionListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollectionListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectionListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectionListReplyValidationError{}
+
+// Validate checks the field values on CollectionVideoActionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CollectionVideoActionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CollectionVideoActionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CollectionVideoActionRequestMultiError, or nil if none found.
+func (m *CollectionVideoActionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CollectionVideoActionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := CollectionVideoActionRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCollectionId() <= 0 {
+		err := CollectionVideoActionRequestValidationError{
+			field:  "CollectionId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVideoId() <= 0 {
+		err := CollectionVideoActionRequestValidationError{
+			field:  "VideoId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ActionType
+
+	if len(errors) > 0 {
+		return CollectionVideoActionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectionVideoActionRequestMultiError is an error wrapping multiple
+// validation errors returned by CollectionVideoActionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CollectionVideoActionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectionVideoActionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectionVideoActionRequestMultiError) AllErrors() []error { return m }
+
+// CollectionVideoActionRequestValidationError is the validation error returned
+// by CollectionVideoActionRequest.Validate if the designated constraints
+// aren't met.
+type CollectionVideoActionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectionVideoActionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectionVideoActionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectionVideoActionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectionVideoActionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectionVideoActionRequestValidationError) ErrorName() string {
+	return "CollectionVideoActionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CollectionVideoActionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollectionVideoActionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectionVideoActionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectionVideoActionRequestValidationError{}
+
+// Validate checks the field values on CollectionVideoActionReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CollectionVideoActionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CollectionVideoActionReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CollectionVideoActionReplyMultiError, or nil if none found.
+func (m *CollectionVideoActionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CollectionVideoActionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	if len(errors) > 0 {
+		return CollectionVideoActionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectionVideoActionReplyMultiError is an error wrapping multiple
+// validation errors returned by CollectionVideoActionReply.ValidateAll() if
+// the designated constraints aren't met.
+type CollectionVideoActionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectionVideoActionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectionVideoActionReplyMultiError) AllErrors() []error { return m }
+
+// CollectionVideoActionReplyValidationError is the validation error returned
+// by CollectionVideoActionReply.Validate if the designated constraints aren't met.
+type CollectionVideoActionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectionVideoActionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectionVideoActionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectionVideoActionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectionVideoActionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectionVideoActionReplyValidationError) ErrorName() string {
+	return "CollectionVideoActionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CollectionVideoActionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollectionVideoActionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectionVideoActionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectionVideoActionReplyValidationError{}
+
+// Validate checks the field values on CollectionVideoListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CollectionVideoListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CollectionVideoListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CollectionVideoListRequestMultiError, or nil if none found.
+func (m *CollectionVideoListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CollectionVideoListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCollectionId() <= 0 {
+		err := CollectionVideoListRequestValidationError{
+			field:  "CollectionId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return CollectionVideoListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectionVideoListRequestMultiError is an error wrapping multiple
+// validation errors returned by CollectionVideoListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CollectionVideoListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectionVideoListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectionVideoListRequestMultiError) AllErrors() []error { return m }
+
+// CollectionVideoListRequestValidationError is the validation error returned
+// by CollectionVideoListRequest.Validate if the designated constraints aren't met.
+type CollectionVideoListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectionVideoListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectionVideoListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectionVideoListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectionVideoListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectionVideoListRequestValidationError) ErrorName() string {
+	return "CollectionVideoListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CollectionVideoListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollectionVideoListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectionVideoListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectionVideoListRequestValidationError{}
+
+// Validate checks the field values on CollectionVideoListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CollectionVideoListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CollectionVideoListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CollectionVideoListReplyMultiError, or nil if none found.
+func (m *CollectionVideoListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CollectionVideoListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	for idx, item := range m.GetVideoList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CollectionVideoListReplyValidationError{
+						field:  fmt.Sprintf("VideoList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CollectionVideoListReplyValidationError{
+						field:  fmt.Sprintf("VideoList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CollectionVideoListReplyValidationError{
+					field:  fmt.Sprintf("VideoList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CollectionVideoListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectionVideoListReplyMultiError is an error wrapping multiple validation
+// errors returned by CollectionVideoListReply.ValidateAll() if the designated
+// constraints aren't met.
+type CollectionVideoListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectionVideoListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectionVideoListReplyMultiError) AllErrors() []error { return m }
+
+// CollectionVideoListReplyValidationError is the validation error returned by
+// CollectionVideoListReply.Validate if the designated constraints aren't met.
+type CollectionVideoListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectionVideoListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectionVideoListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectionVideoListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectionVideoListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectionVideoListReplyValidationError) ErrorName() string {
+	return "CollectionVideoListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CollectionVideoListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollectionVideoListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectionVideoListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectionVideoListReplyValidationError{}
+
+// Validate checks the field values on Collection with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Collection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Collection with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CollectionMultiError, or
+// nil if none found.
+func (m *Collection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Collection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Name
+
+	// no validation rules for IsPrivate
+
+	// no validation rules for VideoCount
+
+	if len(errors) > 0 {
+		return CollectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectionMultiError is an error wrapping multiple validation errors
+// returned by Collection.ValidateAll() if the designated constraints aren't met.
+type CollectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectionMultiError) AllErrors() []error { return m }
+
+// CollectionValidationError is the validation error returned by
+// Collection.Validate if the designated constraints aren't met.
+type CollectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectionValidationError) ErrorName() string { return "CollectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CollectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectionValidationError{}
+
 // Validate checks the field values on Video with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
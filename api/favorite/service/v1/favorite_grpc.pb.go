@@ -20,9 +20,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	FavoriteService_GetFavoriteList_FullMethodName = "/favorite.service.v1.FavoriteService/GetFavoriteList"
-	FavoriteService_FavoriteAction_FullMethodName  = "/favorite.service.v1.FavoriteService/FavoriteAction"
-	FavoriteService_IsFavorite_FullMethodName      = "/favorite.service.v1.FavoriteService/IsFavorite"
+	FavoriteService_GetFavoriteList_FullMethodName        = "/favorite.service.v1.FavoriteService/GetFavoriteList"
+	FavoriteService_FavoriteAction_FullMethodName         = "/favorite.service.v1.FavoriteService/FavoriteAction"
+	FavoriteService_IsFavorite_FullMethodName             = "/favorite.service.v1.FavoriteService/IsFavorite"
+	FavoriteService_CollectionAction_FullMethodName       = "/favorite.service.v1.FavoriteService/CollectionAction"
+	FavoriteService_GetCollectionList_FullMethodName      = "/favorite.service.v1.FavoriteService/GetCollectionList"
+	FavoriteService_CollectionVideoAction_FullMethodName  = "/favorite.service.v1.FavoriteService/CollectionVideoAction"
+	FavoriteService_GetCollectionVideoList_FullMethodName = "/favorite.service.v1.FavoriteService/GetCollectionVideoList"
 )
 
 // FavoriteServiceClient is the client API for FavoriteService service.
@@ -35,6 +39,14 @@ type FavoriteServiceClient interface {
 	FavoriteAction(ctx context.Context, in *FavoriteActionRequest, opts ...grpc.CallOption) (*FavoriteActionReply, error)
 	// 其他服务根据 user_id 和 video_ids 判断是否喜爱
 	IsFavorite(ctx context.Context, in *IsFavoriteRequest, opts ...grpc.CallOption) (*IsFavoriteReply, error)
+	// 创建、删除或修改收藏夹
+	CollectionAction(ctx context.Context, in *CollectionActionRequest, opts ...grpc.CallOption) (*CollectionActionReply, error)
+	// 获取用户的收藏夹列表
+	GetCollectionList(ctx context.Context, in *CollectionListRequest, opts ...grpc.CallOption) (*CollectionListReply, error)
+	// 向收藏夹添加或移除视频
+	CollectionVideoAction(ctx context.Context, in *CollectionVideoActionRequest, opts ...grpc.CallOption) (*CollectionVideoActionReply, error)
+	// 获取收藏夹中的视频列表
+	GetCollectionVideoList(ctx context.Context, in *CollectionVideoListRequest, opts ...grpc.CallOption) (*CollectionVideoListReply, error)
 }
 
 type favoriteServiceClient struct {
@@ -72,6 +84,42 @@ func (c *favoriteServiceClient) IsFavorite(ctx context.Context, in *IsFavoriteRe
 	return out, nil
 }
 
+func (c *favoriteServiceClient) CollectionAction(ctx context.Context, in *CollectionActionRequest, opts ...grpc.CallOption) (*CollectionActionReply, error) {
+	out := new(CollectionActionReply)
+	err := c.cc.Invoke(ctx, FavoriteService_CollectionAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteServiceClient) GetCollectionList(ctx context.Context, in *CollectionListRequest, opts ...grpc.CallOption) (*CollectionListReply, error) {
+	out := new(CollectionListReply)
+	err := c.cc.Invoke(ctx, FavoriteService_GetCollectionList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteServiceClient) CollectionVideoAction(ctx context.Context, in *CollectionVideoActionRequest, opts ...grpc.CallOption) (*CollectionVideoActionReply, error) {
+	out := new(CollectionVideoActionReply)
+	err := c.cc.Invoke(ctx, FavoriteService_CollectionVideoAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteServiceClient) GetCollectionVideoList(ctx context.Context, in *CollectionVideoListRequest, opts ...grpc.CallOption) (*CollectionVideoListReply, error) {
+	out := new(CollectionVideoListReply)
+	err := c.cc.Invoke(ctx, FavoriteService_GetCollectionVideoList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FavoriteServiceServer is the server API for FavoriteService service.
 // All implementations must embed UnimplementedFavoriteServiceServer
 // for forward compatibility
@@ -82,6 +130,14 @@ type FavoriteServiceServer interface {
 	FavoriteAction(context.Context, *FavoriteActionRequest) (*FavoriteActionReply, error)
 	// 其他服务根据 user_id 和 video_ids 判断是否喜爱
 	IsFavorite(context.Context, *IsFavoriteRequest) (*IsFavoriteReply, error)
+	// 创建、删除或修改收藏夹
+	CollectionAction(context.Context, *CollectionActionRequest) (*CollectionActionReply, error)
+	// 获取用户的收藏夹列表
+	GetCollectionList(context.Context, *CollectionListRequest) (*CollectionListReply, error)
+	// 向收藏夹添加或移除视频
+	CollectionVideoAction(context.Context, *CollectionVideoActionRequest) (*CollectionVideoActionReply, error)
+	// 获取收藏夹中的视频列表
+	GetCollectionVideoList(context.Context, *CollectionVideoListRequest) (*CollectionVideoListReply, error)
 	mustEmbedUnimplementedFavoriteServiceServer()
 }
 
@@ -98,6 +154,18 @@ func (UnimplementedFavoriteServiceServer) FavoriteAction(context.Context, *Favor
 func (UnimplementedFavoriteServiceServer) IsFavorite(context.Context, *IsFavoriteRequest) (*IsFavoriteReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsFavorite not implemented")
 }
+func (UnimplementedFavoriteServiceServer) CollectionAction(context.Context, *CollectionActionRequest) (*CollectionActionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectionAction not implemented")
+}
+func (UnimplementedFavoriteServiceServer) GetCollectionList(context.Context, *CollectionListRequest) (*CollectionListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionList not implemented")
+}
+func (UnimplementedFavoriteServiceServer) CollectionVideoAction(context.Context, *CollectionVideoActionRequest) (*CollectionVideoActionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectionVideoAction not implemented")
+}
+func (UnimplementedFavoriteServiceServer) GetCollectionVideoList(context.Context, *CollectionVideoListRequest) (*CollectionVideoListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionVideoList not implemented")
+}
 func (UnimplementedFavoriteServiceServer) mustEmbedUnimplementedFavoriteServiceServer() {}
 
 // UnsafeFavoriteServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -165,6 +233,78 @@ func _FavoriteService_IsFavorite_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FavoriteService_CollectionAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServiceServer).CollectionAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FavoriteService_CollectionAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServiceServer).CollectionAction(ctx, req.(*CollectionActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FavoriteService_GetCollectionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServiceServer).GetCollectionList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FavoriteService_GetCollectionList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServiceServer).GetCollectionList(ctx, req.(*CollectionListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FavoriteService_CollectionVideoAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionVideoActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServiceServer).CollectionVideoAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FavoriteService_CollectionVideoAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServiceServer).CollectionVideoAction(ctx, req.(*CollectionVideoActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FavoriteService_GetCollectionVideoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionVideoListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServiceServer).GetCollectionVideoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FavoriteService_GetCollectionVideoList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServiceServer).GetCollectionVideoList(ctx, req.(*CollectionVideoListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FavoriteService_ServiceDesc is the grpc.ServiceDesc for FavoriteService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -184,6 +324,22 @@ var FavoriteService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsFavorite",
 			Handler:    _FavoriteService_IsFavorite_Handler,
 		},
+		{
+			MethodName: "CollectionAction",
+			Handler:    _FavoriteService_CollectionAction_Handler,
+		},
+		{
+			MethodName: "GetCollectionList",
+			Handler:    _FavoriteService_GetCollectionList_Handler,
+		},
+		{
+			MethodName: "CollectionVideoAction",
+			Handler:    _FavoriteService_CollectionVideoAction_Handler,
+		},
+		{
+			MethodName: "GetCollectionVideoList",
+			Handler:    _FavoriteService_GetCollectionVideoList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "favorite/service/v1/favorite.proto",
@@ -20,12 +20,24 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationFavoriteServiceCollectionAction = "/favorite.service.v1.FavoriteService/CollectionAction"
+const OperationFavoriteServiceCollectionVideoAction = "/favorite.service.v1.FavoriteService/CollectionVideoAction"
 const OperationFavoriteServiceFavoriteAction = "/favorite.service.v1.FavoriteService/FavoriteAction"
+const OperationFavoriteServiceGetCollectionList = "/favorite.service.v1.FavoriteService/GetCollectionList"
+const OperationFavoriteServiceGetCollectionVideoList = "/favorite.service.v1.FavoriteService/GetCollectionVideoList"
 const OperationFavoriteServiceGetFavoriteList = "/favorite.service.v1.FavoriteService/GetFavoriteList"
 
 type FavoriteServiceHTTPServer interface {
+	// CollectionAction 创建、删除或修改收藏夹
+	CollectionAction(context.Context, *CollectionActionRequest) (*CollectionActionReply, error)
+	// CollectionVideoAction 向收藏夹添加或移除视频
+	CollectionVideoAction(context.Context, *CollectionVideoActionRequest) (*CollectionVideoActionReply, error)
 	// FavoriteAction 添加或取消喜爱视频
 	FavoriteAction(context.Context, *FavoriteActionRequest) (*FavoriteActionReply, error)
+	// GetCollectionList 获取用户的收藏夹列表
+	GetCollectionList(context.Context, *CollectionListRequest) (*CollectionListReply, error)
+	// GetCollectionVideoList 获取收藏夹中的视频列表
+	GetCollectionVideoList(context.Context, *CollectionVideoListRequest) (*CollectionVideoListReply, error)
 	// GetFavoriteList 获取喜爱视频列表
 	GetFavoriteList(context.Context, *FavoriteListRequest) (*FavoriteListReply, error)
 }
@@ -34,6 +46,10 @@ func RegisterFavoriteServiceHTTPServer(s *http.Server, srv FavoriteServiceHTTPSe
 	r := s.Route("/")
 	r.GET("/douyin/favorite/list", _FavoriteService_GetFavoriteList0_HTTP_Handler(srv))
 	r.POST("/douyin/favorite/action", _FavoriteService_FavoriteAction0_HTTP_Handler(srv))
+	r.POST("/douyin/favorite/collection/action", _FavoriteService_CollectionAction0_HTTP_Handler(srv))
+	r.GET("/douyin/favorite/collection/list", _FavoriteService_GetCollectionList0_HTTP_Handler(srv))
+	r.POST("/douyin/favorite/collection/video/action", _FavoriteService_CollectionVideoAction0_HTTP_Handler(srv))
+	r.GET("/douyin/favorite/collection/video/list", _FavoriteService_GetCollectionVideoList0_HTTP_Handler(srv))
 }
 
 func _FavoriteService_GetFavoriteList0_HTTP_Handler(srv FavoriteServiceHTTPServer) func(ctx http.Context) error {
@@ -77,8 +93,94 @@ func _FavoriteService_FavoriteAction0_HTTP_Handler(srv FavoriteServiceHTTPServer
 	}
 }
 
+func _FavoriteService_CollectionAction0_HTTP_Handler(srv FavoriteServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CollectionActionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFavoriteServiceCollectionAction)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CollectionAction(ctx, req.(*CollectionActionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CollectionActionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _FavoriteService_GetCollectionList0_HTTP_Handler(srv FavoriteServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CollectionListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFavoriteServiceGetCollectionList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCollectionList(ctx, req.(*CollectionListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CollectionListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _FavoriteService_CollectionVideoAction0_HTTP_Handler(srv FavoriteServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CollectionVideoActionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFavoriteServiceCollectionVideoAction)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CollectionVideoAction(ctx, req.(*CollectionVideoActionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CollectionVideoActionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _FavoriteService_GetCollectionVideoList0_HTTP_Handler(srv FavoriteServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CollectionVideoListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFavoriteServiceGetCollectionVideoList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCollectionVideoList(ctx, req.(*CollectionVideoListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CollectionVideoListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type FavoriteServiceHTTPClient interface {
+	CollectionAction(ctx context.Context, req *CollectionActionRequest, opts ...http.CallOption) (rsp *CollectionActionReply, err error)
+	CollectionVideoAction(ctx context.Context, req *CollectionVideoActionRequest, opts ...http.CallOption) (rsp *CollectionVideoActionReply, err error)
 	FavoriteAction(ctx context.Context, req *FavoriteActionRequest, opts ...http.CallOption) (rsp *FavoriteActionReply, err error)
+	GetCollectionList(ctx context.Context, req *CollectionListRequest, opts ...http.CallOption) (rsp *CollectionListReply, err error)
+	GetCollectionVideoList(ctx context.Context, req *CollectionVideoListRequest, opts ...http.CallOption) (rsp *CollectionVideoListReply, err error)
 	GetFavoriteList(ctx context.Context, req *FavoriteListRequest, opts ...http.CallOption) (rsp *FavoriteListReply, err error)
 }
 
@@ -90,6 +192,32 @@ func NewFavoriteServiceHTTPClient(client *http.Client) FavoriteServiceHTTPClient
 	return &FavoriteServiceHTTPClientImpl{client}
 }
 
+func (c *FavoriteServiceHTTPClientImpl) CollectionAction(ctx context.Context, in *CollectionActionRequest, opts ...http.CallOption) (*CollectionActionReply, error) {
+	var out CollectionActionReply
+	pattern := "/douyin/favorite/collection/action"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationFavoriteServiceCollectionAction))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *FavoriteServiceHTTPClientImpl) CollectionVideoAction(ctx context.Context, in *CollectionVideoActionRequest, opts ...http.CallOption) (*CollectionVideoActionReply, error) {
+	var out CollectionVideoActionReply
+	pattern := "/douyin/favorite/collection/video/action"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationFavoriteServiceCollectionVideoAction))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *FavoriteServiceHTTPClientImpl) FavoriteAction(ctx context.Context, in *FavoriteActionRequest, opts ...http.CallOption) (*FavoriteActionReply, error) {
 	var out FavoriteActionReply
 	pattern := "/douyin/favorite/action"
@@ -103,6 +231,32 @@ func (c *FavoriteServiceHTTPClientImpl) FavoriteAction(ctx context.Context, in *
 	return &out, err
 }
 
+func (c *FavoriteServiceHTTPClientImpl) GetCollectionList(ctx context.Context, in *CollectionListRequest, opts ...http.CallOption) (*CollectionListReply, error) {
+	var out CollectionListReply
+	pattern := "/douyin/favorite/collection/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationFavoriteServiceGetCollectionList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *FavoriteServiceHTTPClientImpl) GetCollectionVideoList(ctx context.Context, in *CollectionVideoListRequest, opts ...http.CallOption) (*CollectionVideoListReply, error) {
+	var out CollectionVideoListReply
+	pattern := "/douyin/favorite/collection/video/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationFavoriteServiceGetCollectionVideoList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *FavoriteServiceHTTPClientImpl) GetFavoriteList(ctx context.Context, in *FavoriteListRequest, opts ...http.CallOption) (*FavoriteListReply, error) {
 	var out FavoriteListReply
 	pattern := "/douyin/favorite/list"
@@ -221,6 +221,8 @@ func (m *MessageListReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for PeerLastReadId
+
 	if len(errors) > 0 {
 		return MessageListReplyMultiError(errors)
 	}
@@ -347,6 +349,40 @@ func (m *MessageActionRequest) validate(all bool) error {
 
 	// no validation rules for Content
 
+	// no validation rules for MessageId
+
+	// no validation rules for ContentType
+
+	if len(m.GetImageData()) > 5242880 {
+		err := MessageActionRequestValidationError{
+			field:  "ImageData",
+			reason: "value length must be at most 5242880 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for VideoId
+
+	// no validation rules for StickerId
+
+	if utf8.RuneCountInString(m.GetClientMsgId()) > 64 {
+		err := MessageActionRequestValidationError{
+			field:  "ClientMsgId",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SenderKeyId
+
+	// no validation rules for RecipientKeyId
+
 	if len(errors) > 0 {
 		return MessageActionRequestMultiError(errors)
 	}
@@ -453,6 +489,8 @@ func (m *MessageActionReply) validate(all bool) error {
 
 	// no validation rules for StatusMsg
 
+	// no validation rules for ClientMsgId
+
 	if len(errors) > 0 {
 		return MessageActionReplyMultiError(errors)
 	}
@@ -533,50 +571,281 @@ var _ interface {
 	ErrorName() string
 } = MessageActionReplyValidationError{}
 
-// Validate checks the field values on Message with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on MarkReadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MarkReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkReadRequestMultiError, or nil if none found.
+func (m *MarkReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := MarkReadRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetToUserId() <= 0 {
+		err := MarkReadRequestValidationError{
+			field:  "ToUserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for MessageId
+
+	if len(errors) > 0 {
+		return MarkReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkReadRequestMultiError is an error wrapping multiple validation errors
+// returned by MarkReadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MarkReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkReadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkReadRequestMultiError) AllErrors() []error { return m }
+
+// MarkReadRequestValidationError is the validation error returned by
+// MarkReadRequest.Validate if the designated constraints aren't met.
+type MarkReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkReadRequestValidationError) ErrorName() string { return "MarkReadRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MarkReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkReadRequestValidationError{}
+
+// Validate checks the field values on MarkReadReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Message) Validate() error {
+func (m *MarkReadReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Message with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in MessageMultiError, or nil if none found.
-func (m *Message) ValidateAll() error {
+// ValidateAll checks the field values on MarkReadReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MarkReadReplyMultiError, or
+// nil if none found.
+func (m *MarkReadReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Message) validate(all bool) error {
+func (m *MarkReadReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for StatusCode
 
-	// no validation rules for ToUserId
+	// no validation rules for StatusMsg
 
-	// no validation rules for FromUserId
+	if len(errors) > 0 {
+		return MarkReadReplyMultiError(errors)
+	}
 
-	// no validation rules for Content
+	return nil
+}
 
-	// no validation rules for CreateTime
+// MarkReadReplyMultiError is an error wrapping multiple validation errors
+// returned by MarkReadReply.ValidateAll() if the designated constraints
+// aren't met.
+type MarkReadReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkReadReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkReadReplyMultiError) AllErrors() []error { return m }
+
+// MarkReadReplyValidationError is the validation error returned by
+// MarkReadReply.Validate if the designated constraints aren't met.
+type MarkReadReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkReadReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkReadReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkReadReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkReadReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkReadReplyValidationError) ErrorName() string { return "MarkReadReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MarkReadReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkReadReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkReadReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkReadReplyValidationError{}
+
+// Validate checks the field values on UnreadCountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnreadCountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnreadCountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnreadCountRequestMultiError, or nil if none found.
+func (m *UnreadCountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnreadCountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := UnreadCountRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return MessageMultiError(errors)
+		return UnreadCountRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MessageMultiError is an error wrapping multiple validation errors returned
-// by Message.ValidateAll() if the designated constraints aren't met.
-type MessageMultiError []error
+// UnreadCountRequestMultiError is an error wrapping multiple validation errors
+// returned by UnreadCountRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnreadCountRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MessageMultiError) Error() string {
+func (m UnreadCountRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -585,11 +854,11 @@ func (m MessageMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MessageMultiError) AllErrors() []error { return m }
+func (m UnreadCountRequestMultiError) AllErrors() []error { return m }
 
-// MessageValidationError is the validation error returned by Message.Validate
-// if the designated constraints aren't met.
-type MessageValidationError struct {
+// UnreadCountRequestValidationError is the validation error returned by
+// UnreadCountRequest.Validate if the designated constraints aren't met.
+type UnreadCountRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -597,22 +866,24 @@ type MessageValidationError struct {
 }
 
 // Field function returns field value.
-func (e MessageValidationError) Field() string { return e.field }
+func (e UnreadCountRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MessageValidationError) Reason() string { return e.reason }
+func (e UnreadCountRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MessageValidationError) Cause() error { return e.cause }
+func (e UnreadCountRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MessageValidationError) Key() bool { return e.key }
+func (e UnreadCountRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MessageValidationError) ErrorName() string { return "MessageValidationError" }
+func (e UnreadCountRequestValidationError) ErrorName() string {
+	return "UnreadCountRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MessageValidationError) Error() string {
+func (e UnreadCountRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -624,14 +895,14 @@ func (e MessageValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMessage.%s: %s%s",
+		"invalid %sUnreadCountRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MessageValidationError{}
+var _ error = UnreadCountRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -639,4 +910,3099 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MessageValidationError{}
+} = UnreadCountRequestValidationError{}
+
+// Validate checks the field values on UnreadCountReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UnreadCountReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnreadCountReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnreadCountReplyMultiError, or nil if none found.
+func (m *UnreadCountReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnreadCountReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	// no validation rules for TotalCount
+
+	for idx, item := range m.GetConversations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UnreadCountReplyValidationError{
+						field:  fmt.Sprintf("Conversations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UnreadCountReplyValidationError{
+						field:  fmt.Sprintf("Conversations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UnreadCountReplyValidationError{
+					field:  fmt.Sprintf("Conversations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UnreadCountReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnreadCountReplyMultiError is an error wrapping multiple validation errors
+// returned by UnreadCountReply.ValidateAll() if the designated constraints
+// aren't met.
+type UnreadCountReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnreadCountReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnreadCountReplyMultiError) AllErrors() []error { return m }
+
+// UnreadCountReplyValidationError is the validation error returned by
+// UnreadCountReply.Validate if the designated constraints aren't met.
+type UnreadCountReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnreadCountReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnreadCountReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnreadCountReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnreadCountReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnreadCountReplyValidationError) ErrorName() string { return "UnreadCountReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnreadCountReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnreadCountReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnreadCountReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnreadCountReplyValidationError{}
+
+// Validate checks the field values on UnreadConversation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnreadConversation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnreadConversation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnreadConversationMultiError, or nil if none found.
+func (m *UnreadConversation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnreadConversation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ToUserId
+
+	// no validation rules for UnreadCount
+
+	if len(errors) > 0 {
+		return UnreadConversationMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnreadConversationMultiError is an error wrapping multiple validation errors
+// returned by UnreadConversation.ValidateAll() if the designated constraints
+// aren't met.
+type UnreadConversationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnreadConversationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnreadConversationMultiError) AllErrors() []error { return m }
+
+// UnreadConversationValidationError is the validation error returned by
+// UnreadConversation.Validate if the designated constraints aren't met.
+type UnreadConversationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnreadConversationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnreadConversationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnreadConversationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnreadConversationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnreadConversationValidationError) ErrorName() string {
+	return "UnreadConversationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnreadConversationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnreadConversation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnreadConversationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnreadConversationValidationError{}
+
+// Validate checks the field values on PushEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PushEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PushEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PushEventMultiError, or nil
+// if none found.
+func (m *PushEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PushEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PushEventValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PushEventValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PushEventValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReceipt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PushEventValidationError{
+					field:  "Receipt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PushEventValidationError{
+					field:  "Receipt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReceipt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PushEventValidationError{
+				field:  "Receipt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetGroupMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PushEventValidationError{
+					field:  "GroupMessage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PushEventValidationError{
+					field:  "GroupMessage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGroupMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PushEventValidationError{
+				field:  "GroupMessage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PushEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// PushEventMultiError is an error wrapping multiple validation errors returned
+// by PushEvent.ValidateAll() if the designated constraints aren't met.
+type PushEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PushEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PushEventMultiError) AllErrors() []error { return m }
+
+// PushEventValidationError is the validation error returned by
+// PushEvent.Validate if the designated constraints aren't met.
+type PushEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PushEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PushEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PushEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PushEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PushEventValidationError) ErrorName() string { return "PushEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PushEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPushEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PushEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PushEventValidationError{}
+
+// Validate checks the field values on ReadReceipt with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReadReceipt) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadReceipt with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReadReceiptMultiError, or
+// nil if none found.
+func (m *ReadReceipt) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadReceipt) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ToUserId
+
+	// no validation rules for LastReadId
+
+	if len(errors) > 0 {
+		return ReadReceiptMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadReceiptMultiError is an error wrapping multiple validation errors
+// returned by ReadReceipt.ValidateAll() if the designated constraints aren't met.
+type ReadReceiptMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadReceiptMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadReceiptMultiError) AllErrors() []error { return m }
+
+// ReadReceiptValidationError is the validation error returned by
+// ReadReceipt.Validate if the designated constraints aren't met.
+type ReadReceiptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadReceiptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadReceiptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadReceiptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadReceiptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadReceiptValidationError) ErrorName() string { return "ReadReceiptValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReadReceiptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadReceipt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadReceiptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadReceiptValidationError{}
+
+// Validate checks the field values on ConversationListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConversationListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConversationListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConversationListRequestMultiError, or nil if none found.
+func (m *ConversationListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConversationListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := ConversationListRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Cursor
+
+	if m.GetCount() > 50 {
+		err := ConversationListRequestValidationError{
+			field:  "Count",
+			reason: "value must be less than or equal to 50",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ConversationListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConversationListRequestMultiError is an error wrapping multiple validation
+// errors returned by ConversationListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ConversationListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConversationListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConversationListRequestMultiError) AllErrors() []error { return m }
+
+// ConversationListRequestValidationError is the validation error returned by
+// ConversationListRequest.Validate if the designated constraints aren't met.
+type ConversationListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConversationListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConversationListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConversationListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConversationListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConversationListRequestValidationError) ErrorName() string {
+	return "ConversationListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConversationListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConversationListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConversationListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConversationListRequestValidationError{}
+
+// Validate checks the field values on ConversationListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConversationListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConversationListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConversationListReplyMultiError, or nil if none found.
+func (m *ConversationListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConversationListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	for idx, item := range m.GetConversationList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConversationListReplyValidationError{
+						field:  fmt.Sprintf("ConversationList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConversationListReplyValidationError{
+						field:  fmt.Sprintf("ConversationList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConversationListReplyValidationError{
+					field:  fmt.Sprintf("ConversationList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ConversationListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConversationListReplyMultiError is an error wrapping multiple validation
+// errors returned by ConversationListReply.ValidateAll() if the designated
+// constraints aren't met.
+type ConversationListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConversationListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConversationListReplyMultiError) AllErrors() []error { return m }
+
+// ConversationListReplyValidationError is the validation error returned by
+// ConversationListReply.Validate if the designated constraints aren't met.
+type ConversationListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConversationListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConversationListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConversationListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConversationListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConversationListReplyValidationError) ErrorName() string {
+	return "ConversationListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConversationListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConversationListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConversationListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConversationListReplyValidationError{}
+
+// Validate checks the field values on Conversation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Conversation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Conversation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConversationMultiError, or
+// nil if none found.
+func (m *Conversation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Conversation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ToUserId
+
+	if all {
+		switch v := interface{}(m.GetLastMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConversationValidationError{
+					field:  "LastMessage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConversationValidationError{
+					field:  "LastMessage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConversationValidationError{
+				field:  "LastMessage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UpdateTime
+
+	if len(errors) > 0 {
+		return ConversationMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConversationMultiError is an error wrapping multiple validation errors
+// returned by Conversation.ValidateAll() if the designated constraints aren't met.
+type ConversationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConversationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConversationMultiError) AllErrors() []error { return m }
+
+// ConversationValidationError is the validation error returned by
+// Conversation.Validate if the designated constraints aren't met.
+type ConversationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConversationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConversationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConversationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConversationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConversationValidationError) ErrorName() string { return "ConversationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConversationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConversation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConversationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConversationValidationError{}
+
+// Validate checks the field values on CreateGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateGroupRequestMultiError, or nil if none found.
+func (m *CreateGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := CreateGroupRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := CreateGroupRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGroupRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateGroupRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGroupRequestMultiError) AllErrors() []error { return m }
+
+// CreateGroupRequestValidationError is the validation error returned by
+// CreateGroupRequest.Validate if the designated constraints aren't met.
+type CreateGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGroupRequestValidationError) ErrorName() string {
+	return "CreateGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGroupRequestValidationError{}
+
+// Validate checks the field values on CreateGroupReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateGroupReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateGroupReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateGroupReplyMultiError, or nil if none found.
+func (m *CreateGroupReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGroupReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	if all {
+		switch v := interface{}(m.GetGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateGroupReplyValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateGroupReplyValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateGroupReplyValidationError{
+				field:  "Group",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateGroupReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGroupReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateGroupReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateGroupReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGroupReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGroupReplyMultiError) AllErrors() []error { return m }
+
+// CreateGroupReplyValidationError is the validation error returned by
+// CreateGroupReply.Validate if the designated constraints aren't met.
+type CreateGroupReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGroupReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGroupReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGroupReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGroupReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGroupReplyValidationError) ErrorName() string { return "CreateGroupReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateGroupReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGroupReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGroupReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGroupReplyValidationError{}
+
+// Validate checks the field values on RenameGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameGroupRequestMultiError, or nil if none found.
+func (m *RenameGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := RenameGroupRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGroupId() <= 0 {
+		err := RenameGroupRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := RenameGroupRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RenameGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameGroupRequestMultiError is an error wrapping multiple validation errors
+// returned by RenameGroupRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RenameGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameGroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameGroupRequestMultiError) AllErrors() []error { return m }
+
+// RenameGroupRequestValidationError is the validation error returned by
+// RenameGroupRequest.Validate if the designated constraints aren't met.
+type RenameGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameGroupRequestValidationError) ErrorName() string {
+	return "RenameGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameGroupRequestValidationError{}
+
+// Validate checks the field values on AddGroupMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddGroupMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddGroupMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddGroupMembersRequestMultiError, or nil if none found.
+func (m *AddGroupMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddGroupMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := AddGroupMembersRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGroupId() <= 0 {
+		err := AddGroupMembersRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddGroupMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddGroupMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by AddGroupMembersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddGroupMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddGroupMembersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddGroupMembersRequestMultiError) AllErrors() []error { return m }
+
+// AddGroupMembersRequestValidationError is the validation error returned by
+// AddGroupMembersRequest.Validate if the designated constraints aren't met.
+type AddGroupMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddGroupMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddGroupMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddGroupMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddGroupMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddGroupMembersRequestValidationError) ErrorName() string {
+	return "AddGroupMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddGroupMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddGroupMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddGroupMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddGroupMembersRequestValidationError{}
+
+// Validate checks the field values on RemoveGroupMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveGroupMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveGroupMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveGroupMemberRequestMultiError, or nil if none found.
+func (m *RemoveGroupMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveGroupMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := RemoveGroupMemberRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGroupId() <= 0 {
+		err := RemoveGroupMemberRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() <= 0 {
+		err := RemoveGroupMemberRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveGroupMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveGroupMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveGroupMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveGroupMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveGroupMemberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveGroupMemberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveGroupMemberRequestValidationError is the validation error returned by
+// RemoveGroupMemberRequest.Validate if the designated constraints aren't met.
+type RemoveGroupMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveGroupMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveGroupMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveGroupMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveGroupMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveGroupMemberRequestValidationError) ErrorName() string {
+	return "RemoveGroupMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveGroupMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveGroupMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveGroupMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveGroupMemberRequestValidationError{}
+
+// Validate checks the field values on LeaveGroupRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LeaveGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaveGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeaveGroupRequestMultiError, or nil if none found.
+func (m *LeaveGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaveGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := LeaveGroupRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGroupId() <= 0 {
+		err := LeaveGroupRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LeaveGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaveGroupRequestMultiError is an error wrapping multiple validation errors
+// returned by LeaveGroupRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LeaveGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaveGroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaveGroupRequestMultiError) AllErrors() []error { return m }
+
+// LeaveGroupRequestValidationError is the validation error returned by
+// LeaveGroupRequest.Validate if the designated constraints aren't met.
+type LeaveGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaveGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaveGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaveGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaveGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaveGroupRequestValidationError) ErrorName() string {
+	return "LeaveGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LeaveGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaveGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaveGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaveGroupRequestValidationError{}
+
+// Validate checks the field values on GroupMessageActionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GroupMessageActionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupMessageActionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupMessageActionRequestMultiError, or nil if none found.
+func (m *GroupMessageActionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupMessageActionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := GroupMessageActionRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGroupId() <= 0 {
+		err := GroupMessageActionRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetContent()) < 1 {
+		err := GroupMessageActionRequestValidationError{
+			field:  "Content",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetClientMsgId()) > 64 {
+		err := GroupMessageActionRequestValidationError{
+			field:  "ClientMsgId",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GroupMessageActionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupMessageActionRequestMultiError is an error wrapping multiple validation
+// errors returned by GroupMessageActionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GroupMessageActionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupMessageActionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupMessageActionRequestMultiError) AllErrors() []error { return m }
+
+// GroupMessageActionRequestValidationError is the validation error returned by
+// GroupMessageActionRequest.Validate if the designated constraints aren't met.
+type GroupMessageActionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupMessageActionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupMessageActionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupMessageActionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupMessageActionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupMessageActionRequestValidationError) ErrorName() string {
+	return "GroupMessageActionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupMessageActionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupMessageActionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupMessageActionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupMessageActionRequestValidationError{}
+
+// Validate checks the field values on GroupActionReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GroupActionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupActionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupActionReplyMultiError, or nil if none found.
+func (m *GroupActionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupActionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	if len(errors) > 0 {
+		return GroupActionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupActionReplyMultiError is an error wrapping multiple validation errors
+// returned by GroupActionReply.ValidateAll() if the designated constraints
+// aren't met.
+type GroupActionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupActionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupActionReplyMultiError) AllErrors() []error { return m }
+
+// GroupActionReplyValidationError is the validation error returned by
+// GroupActionReply.Validate if the designated constraints aren't met.
+type GroupActionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupActionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupActionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupActionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupActionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupActionReplyValidationError) ErrorName() string { return "GroupActionReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupActionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupActionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupActionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupActionReplyValidationError{}
+
+// Validate checks the field values on GroupMessageListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GroupMessageListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupMessageListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupMessageListRequestMultiError, or nil if none found.
+func (m *GroupMessageListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupMessageListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := GroupMessageListRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGroupId() <= 0 {
+		err := GroupMessageListRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Cursor
+
+	if m.GetCount() > 50 {
+		err := GroupMessageListRequestValidationError{
+			field:  "Count",
+			reason: "value must be less than or equal to 50",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GroupMessageListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupMessageListRequestMultiError is an error wrapping multiple validation
+// errors returned by GroupMessageListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GroupMessageListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupMessageListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupMessageListRequestMultiError) AllErrors() []error { return m }
+
+// GroupMessageListRequestValidationError is the validation error returned by
+// GroupMessageListRequest.Validate if the designated constraints aren't met.
+type GroupMessageListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupMessageListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupMessageListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupMessageListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupMessageListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupMessageListRequestValidationError) ErrorName() string {
+	return "GroupMessageListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupMessageListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupMessageListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupMessageListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupMessageListRequestValidationError{}
+
+// Validate checks the field values on GroupMessageListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GroupMessageListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupMessageListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupMessageListReplyMultiError, or nil if none found.
+func (m *GroupMessageListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupMessageListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	for idx, item := range m.GetMessageList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GroupMessageListReplyValidationError{
+						field:  fmt.Sprintf("MessageList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GroupMessageListReplyValidationError{
+						field:  fmt.Sprintf("MessageList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GroupMessageListReplyValidationError{
+					field:  fmt.Sprintf("MessageList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return GroupMessageListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupMessageListReplyMultiError is an error wrapping multiple validation
+// errors returned by GroupMessageListReply.ValidateAll() if the designated
+// constraints aren't met.
+type GroupMessageListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupMessageListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupMessageListReplyMultiError) AllErrors() []error { return m }
+
+// GroupMessageListReplyValidationError is the validation error returned by
+// GroupMessageListReply.Validate if the designated constraints aren't met.
+type GroupMessageListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupMessageListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupMessageListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupMessageListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupMessageListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupMessageListReplyValidationError) ErrorName() string {
+	return "GroupMessageListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupMessageListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupMessageListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupMessageListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupMessageListReplyValidationError{}
+
+// Validate checks the field values on Group with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Group) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Group with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in GroupMultiError, or nil if none found.
+func (m *Group) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Group) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for OwnerId
+
+	// no validation rules for MemberCount
+
+	// no validation rules for CreateTime
+
+	if len(errors) > 0 {
+		return GroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupMultiError is an error wrapping multiple validation errors returned by
+// Group.ValidateAll() if the designated constraints aren't met.
+type GroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupMultiError) AllErrors() []error { return m }
+
+// GroupValidationError is the validation error returned by Group.Validate if
+// the designated constraints aren't met.
+type GroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupValidationError) ErrorName() string { return "GroupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupValidationError{}
+
+// Validate checks the field values on GroupMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GroupMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GroupMessageMultiError, or
+// nil if none found.
+func (m *GroupMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for GroupId
+
+	// no validation rules for FromUserId
+
+	// no validation rules for Content
+
+	// no validation rules for CreateTime
+
+	if len(errors) > 0 {
+		return GroupMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupMessageMultiError is an error wrapping multiple validation errors
+// returned by GroupMessage.ValidateAll() if the designated constraints aren't met.
+type GroupMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupMessageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupMessageMultiError) AllErrors() []error { return m }
+
+// GroupMessageValidationError is the validation error returned by
+// GroupMessage.Validate if the designated constraints aren't met.
+type GroupMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupMessageValidationError) ErrorName() string { return "GroupMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupMessageValidationError{}
+
+// Validate checks the field values on LatestMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LatestMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LatestMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LatestMessagesRequestMultiError, or nil if none found.
+func (m *LatestMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LatestMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return LatestMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LatestMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by LatestMessagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type LatestMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LatestMessagesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LatestMessagesRequestMultiError) AllErrors() []error { return m }
+
+// LatestMessagesRequestValidationError is the validation error returned by
+// LatestMessagesRequest.Validate if the designated constraints aren't met.
+type LatestMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LatestMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LatestMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LatestMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LatestMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LatestMessagesRequestValidationError) ErrorName() string {
+	return "LatestMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LatestMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLatestMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LatestMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LatestMessagesRequestValidationError{}
+
+// Validate checks the field values on LatestMessagesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LatestMessagesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LatestMessagesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LatestMessagesReplyMultiError, or nil if none found.
+func (m *LatestMessagesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LatestMessagesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LatestMessagesReplyValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LatestMessagesReplyValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LatestMessagesReplyValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return LatestMessagesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// LatestMessagesReplyMultiError is an error wrapping multiple validation
+// errors returned by LatestMessagesReply.ValidateAll() if the designated
+// constraints aren't met.
+type LatestMessagesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LatestMessagesReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LatestMessagesReplyMultiError) AllErrors() []error { return m }
+
+// LatestMessagesReplyValidationError is the validation error returned by
+// LatestMessagesReply.Validate if the designated constraints aren't met.
+type LatestMessagesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LatestMessagesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LatestMessagesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LatestMessagesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LatestMessagesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LatestMessagesReplyValidationError) ErrorName() string {
+	return "LatestMessagesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LatestMessagesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLatestMessagesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LatestMessagesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LatestMessagesReplyValidationError{}
+
+// Validate checks the field values on LatestMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LatestMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LatestMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LatestMessageMultiError, or
+// nil if none found.
+func (m *LatestMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LatestMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ToUserId
+
+	if all {
+		switch v := interface{}(m.GetMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LatestMessageValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LatestMessageValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LatestMessageValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MsgType
+
+	// no validation rules for UnreadCount
+
+	if len(errors) > 0 {
+		return LatestMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// LatestMessageMultiError is an error wrapping multiple validation errors
+// returned by LatestMessage.ValidateAll() if the designated constraints
+// aren't met.
+type LatestMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LatestMessageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LatestMessageMultiError) AllErrors() []error { return m }
+
+// LatestMessageValidationError is the validation error returned by
+// LatestMessage.Validate if the designated constraints aren't met.
+type LatestMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LatestMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LatestMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LatestMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LatestMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LatestMessageValidationError) ErrorName() string { return "LatestMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LatestMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLatestMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LatestMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LatestMessageValidationError{}
+
+// Validate checks the field values on Message with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Message) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Message with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MessageMultiError, or nil if none found.
+func (m *Message) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Message) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ToUserId
+
+	// no validation rules for FromUserId
+
+	// no validation rules for Content
+
+	// no validation rules for CreateTime
+
+	// no validation rules for Recalled
+
+	// no validation rules for ContentType
+
+	// no validation rules for ImageUrl
+
+	if all {
+		switch v := interface{}(m.GetVideo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageValidationError{
+					field:  "Video",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageValidationError{
+					field:  "Video",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVideo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageValidationError{
+				field:  "Video",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for StickerId
+
+	// no validation rules for ClientMsgId
+
+	// no validation rules for SenderKeyId
+
+	// no validation rules for RecipientKeyId
+
+	if len(errors) > 0 {
+		return MessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageMultiError is an error wrapping multiple validation errors returned
+// by Message.ValidateAll() if the designated constraints aren't met.
+type MessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageMultiError) AllErrors() []error { return m }
+
+// MessageValidationError is the validation error returned by Message.Validate
+// if the designated constraints aren't met.
+type MessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageValidationError) ErrorName() string { return "MessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageValidationError{}
+
+// Validate checks the field values on SharedVideo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SharedVideo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SharedVideo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SharedVideoMultiError, or
+// nil if none found.
+func (m *SharedVideo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SharedVideo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for AuthorId
+
+	// no validation rules for Title
+
+	// no validation rules for PlayUrl
+
+	// no validation rules for CoverUrl
+
+	if len(errors) > 0 {
+		return SharedVideoMultiError(errors)
+	}
+
+	return nil
+}
+
+// SharedVideoMultiError is an error wrapping multiple validation errors
+// returned by SharedVideo.ValidateAll() if the designated constraints aren't met.
+type SharedVideoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SharedVideoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SharedVideoMultiError) AllErrors() []error { return m }
+
+// SharedVideoValidationError is the validation error returned by
+// SharedVideo.Validate if the designated constraints aren't met.
+type SharedVideoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SharedVideoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SharedVideoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SharedVideoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SharedVideoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SharedVideoValidationError) ErrorName() string { return "SharedVideoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SharedVideoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSharedVideo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SharedVideoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SharedVideoValidationError{}
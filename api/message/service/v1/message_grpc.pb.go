@@ -20,8 +20,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	MessageService_GetMessageList_FullMethodName = "/message.service.v1.messageService/GetMessageList"
-	MessageService_MessageAction_FullMethodName  = "/message.service.v1.messageService/MessageAction"
+	MessageService_GetMessageList_FullMethodName      = "/message.service.v1.messageService/GetMessageList"
+	MessageService_MessageAction_FullMethodName       = "/message.service.v1.messageService/MessageAction"
+	MessageService_MarkRead_FullMethodName            = "/message.service.v1.messageService/MarkRead"
+	MessageService_GetUnreadCount_FullMethodName      = "/message.service.v1.messageService/GetUnreadCount"
+	MessageService_ListConversations_FullMethodName   = "/message.service.v1.messageService/ListConversations"
+	MessageService_CreateGroup_FullMethodName         = "/message.service.v1.messageService/CreateGroup"
+	MessageService_RenameGroup_FullMethodName         = "/message.service.v1.messageService/RenameGroup"
+	MessageService_AddGroupMembers_FullMethodName     = "/message.service.v1.messageService/AddGroupMembers"
+	MessageService_RemoveGroupMember_FullMethodName   = "/message.service.v1.messageService/RemoveGroupMember"
+	MessageService_LeaveGroup_FullMethodName          = "/message.service.v1.messageService/LeaveGroup"
+	MessageService_GroupMessageAction_FullMethodName  = "/message.service.v1.messageService/GroupMessageAction"
+	MessageService_GetGroupMessageList_FullMethodName = "/message.service.v1.messageService/GetGroupMessageList"
+	MessageService_GetLatestMessages_FullMethodName   = "/message.service.v1.messageService/GetLatestMessages"
 )
 
 // MessageServiceClient is the client API for MessageService service.
@@ -30,6 +41,28 @@ const (
 type MessageServiceClient interface {
 	GetMessageList(ctx context.Context, in *MessageListRequest, opts ...grpc.CallOption) (*MessageListReply, error)
 	MessageAction(ctx context.Context, in *MessageActionRequest, opts ...grpc.CallOption) (*MessageActionReply, error)
+	// 将与对方的聊天记录标记为已读，并向对方发送已读回执
+	MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadReply, error)
+	// 获取每个会话和全部会话的未读消息数量
+	GetUnreadCount(ctx context.Context, in *UnreadCountRequest, opts ...grpc.CallOption) (*UnreadCountReply, error)
+	// 按最近消息时间倒序分页获取会话列表
+	ListConversations(ctx context.Context, in *ConversationListRequest, opts ...grpc.CallOption) (*ConversationListReply, error)
+	// 创建群聊
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupReply, error)
+	// 修改群名称
+	RenameGroup(ctx context.Context, in *RenameGroupRequest, opts ...grpc.CallOption) (*GroupActionReply, error)
+	// 邀请用户加入群聊
+	AddGroupMembers(ctx context.Context, in *AddGroupMembersRequest, opts ...grpc.CallOption) (*GroupActionReply, error)
+	// 将用户移出群聊
+	RemoveGroupMember(ctx context.Context, in *RemoveGroupMemberRequest, opts ...grpc.CallOption) (*GroupActionReply, error)
+	// 退出群聊
+	LeaveGroup(ctx context.Context, in *LeaveGroupRequest, opts ...grpc.CallOption) (*GroupActionReply, error)
+	// 发送群消息
+	GroupMessageAction(ctx context.Context, in *GroupMessageActionRequest, opts ...grpc.CallOption) (*GroupActionReply, error)
+	// 按从新到旧分页获取群消息
+	GetGroupMessageList(ctx context.Context, in *GroupMessageListRequest, opts ...grpc.CallOption) (*GroupMessageListReply, error)
+	// 获取用户与多个用户的最新消息和未读消息数量(relation)
+	GetLatestMessages(ctx context.Context, in *LatestMessagesRequest, opts ...grpc.CallOption) (*LatestMessagesReply, error)
 }
 
 type messageServiceClient struct {
@@ -58,12 +91,133 @@ func (c *messageServiceClient) MessageAction(ctx context.Context, in *MessageAct
 	return out, nil
 }
 
+func (c *messageServiceClient) MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadReply, error) {
+	out := new(MarkReadReply)
+	err := c.cc.Invoke(ctx, MessageService_MarkRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) GetUnreadCount(ctx context.Context, in *UnreadCountRequest, opts ...grpc.CallOption) (*UnreadCountReply, error) {
+	out := new(UnreadCountReply)
+	err := c.cc.Invoke(ctx, MessageService_GetUnreadCount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) ListConversations(ctx context.Context, in *ConversationListRequest, opts ...grpc.CallOption) (*ConversationListReply, error) {
+	out := new(ConversationListReply)
+	err := c.cc.Invoke(ctx, MessageService_ListConversations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupReply, error) {
+	out := new(CreateGroupReply)
+	err := c.cc.Invoke(ctx, MessageService_CreateGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) RenameGroup(ctx context.Context, in *RenameGroupRequest, opts ...grpc.CallOption) (*GroupActionReply, error) {
+	out := new(GroupActionReply)
+	err := c.cc.Invoke(ctx, MessageService_RenameGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) AddGroupMembers(ctx context.Context, in *AddGroupMembersRequest, opts ...grpc.CallOption) (*GroupActionReply, error) {
+	out := new(GroupActionReply)
+	err := c.cc.Invoke(ctx, MessageService_AddGroupMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) RemoveGroupMember(ctx context.Context, in *RemoveGroupMemberRequest, opts ...grpc.CallOption) (*GroupActionReply, error) {
+	out := new(GroupActionReply)
+	err := c.cc.Invoke(ctx, MessageService_RemoveGroupMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) LeaveGroup(ctx context.Context, in *LeaveGroupRequest, opts ...grpc.CallOption) (*GroupActionReply, error) {
+	out := new(GroupActionReply)
+	err := c.cc.Invoke(ctx, MessageService_LeaveGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) GroupMessageAction(ctx context.Context, in *GroupMessageActionRequest, opts ...grpc.CallOption) (*GroupActionReply, error) {
+	out := new(GroupActionReply)
+	err := c.cc.Invoke(ctx, MessageService_GroupMessageAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) GetGroupMessageList(ctx context.Context, in *GroupMessageListRequest, opts ...grpc.CallOption) (*GroupMessageListReply, error) {
+	out := new(GroupMessageListReply)
+	err := c.cc.Invoke(ctx, MessageService_GetGroupMessageList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) GetLatestMessages(ctx context.Context, in *LatestMessagesRequest, opts ...grpc.CallOption) (*LatestMessagesReply, error) {
+	out := new(LatestMessagesReply)
+	err := c.cc.Invoke(ctx, MessageService_GetLatestMessages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessageServiceServer is the server API for MessageService service.
 // All implementations must embed UnimplementedMessageServiceServer
 // for forward compatibility
 type MessageServiceServer interface {
 	GetMessageList(context.Context, *MessageListRequest) (*MessageListReply, error)
 	MessageAction(context.Context, *MessageActionRequest) (*MessageActionReply, error)
+	// 将与对方的聊天记录标记为已读，并向对方发送已读回执
+	MarkRead(context.Context, *MarkReadRequest) (*MarkReadReply, error)
+	// 获取每个会话和全部会话的未读消息数量
+	GetUnreadCount(context.Context, *UnreadCountRequest) (*UnreadCountReply, error)
+	// 按最近消息时间倒序分页获取会话列表
+	ListConversations(context.Context, *ConversationListRequest) (*ConversationListReply, error)
+	// 创建群聊
+	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupReply, error)
+	// 修改群名称
+	RenameGroup(context.Context, *RenameGroupRequest) (*GroupActionReply, error)
+	// 邀请用户加入群聊
+	AddGroupMembers(context.Context, *AddGroupMembersRequest) (*GroupActionReply, error)
+	// 将用户移出群聊
+	RemoveGroupMember(context.Context, *RemoveGroupMemberRequest) (*GroupActionReply, error)
+	// 退出群聊
+	LeaveGroup(context.Context, *LeaveGroupRequest) (*GroupActionReply, error)
+	// 发送群消息
+	GroupMessageAction(context.Context, *GroupMessageActionRequest) (*GroupActionReply, error)
+	// 按从新到旧分页获取群消息
+	GetGroupMessageList(context.Context, *GroupMessageListRequest) (*GroupMessageListReply, error)
+	// 获取用户与多个用户的最新消息和未读消息数量(relation)
+	GetLatestMessages(context.Context, *LatestMessagesRequest) (*LatestMessagesReply, error)
 	mustEmbedUnimplementedMessageServiceServer()
 }
 
@@ -77,6 +231,39 @@ func (UnimplementedMessageServiceServer) GetMessageList(context.Context, *Messag
 func (UnimplementedMessageServiceServer) MessageAction(context.Context, *MessageActionRequest) (*MessageActionReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageAction not implemented")
 }
+func (UnimplementedMessageServiceServer) MarkRead(context.Context, *MarkReadRequest) (*MarkReadReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkRead not implemented")
+}
+func (UnimplementedMessageServiceServer) GetUnreadCount(context.Context, *UnreadCountRequest) (*UnreadCountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadCount not implemented")
+}
+func (UnimplementedMessageServiceServer) ListConversations(context.Context, *ConversationListRequest) (*ConversationListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConversations not implemented")
+}
+func (UnimplementedMessageServiceServer) CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedMessageServiceServer) RenameGroup(context.Context, *RenameGroupRequest) (*GroupActionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameGroup not implemented")
+}
+func (UnimplementedMessageServiceServer) AddGroupMembers(context.Context, *AddGroupMembersRequest) (*GroupActionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGroupMembers not implemented")
+}
+func (UnimplementedMessageServiceServer) RemoveGroupMember(context.Context, *RemoveGroupMemberRequest) (*GroupActionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGroupMember not implemented")
+}
+func (UnimplementedMessageServiceServer) LeaveGroup(context.Context, *LeaveGroupRequest) (*GroupActionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
+}
+func (UnimplementedMessageServiceServer) GroupMessageAction(context.Context, *GroupMessageActionRequest) (*GroupActionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupMessageAction not implemented")
+}
+func (UnimplementedMessageServiceServer) GetGroupMessageList(context.Context, *GroupMessageListRequest) (*GroupMessageListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupMessageList not implemented")
+}
+func (UnimplementedMessageServiceServer) GetLatestMessages(context.Context, *LatestMessagesRequest) (*LatestMessagesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestMessages not implemented")
+}
 func (UnimplementedMessageServiceServer) mustEmbedUnimplementedMessageServiceServer() {}
 
 // UnsafeMessageServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -126,6 +313,204 @@ func _MessageService_MessageAction_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MessageService_MarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).MarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_MarkRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).MarkRead(ctx, req.(*MarkReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_GetUnreadCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnreadCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).GetUnreadCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_GetUnreadCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).GetUnreadCount(ctx, req.(*UnreadCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_ListConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConversationListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).ListConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_ListConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).ListConversations(ctx, req.(*ConversationListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_CreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_RenameGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).RenameGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_RenameGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).RenameGroup(ctx, req.(*RenameGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_AddGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).AddGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_AddGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).AddGroupMembers(ctx, req.(*AddGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_RemoveGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).RemoveGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_RemoveGroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).RemoveGroupMember(ctx, req.(*RemoveGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).LeaveGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_LeaveGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).LeaveGroup(ctx, req.(*LeaveGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_GroupMessageAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupMessageActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).GroupMessageAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_GroupMessageAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).GroupMessageAction(ctx, req.(*GroupMessageActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_GetGroupMessageList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupMessageListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).GetGroupMessageList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_GetGroupMessageList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).GetGroupMessageList(ctx, req.(*GroupMessageListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_GetLatestMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LatestMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).GetLatestMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_GetLatestMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).GetLatestMessages(ctx, req.(*LatestMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MessageService_ServiceDesc is the grpc.ServiceDesc for MessageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -141,6 +526,50 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MessageAction",
 			Handler:    _MessageService_MessageAction_Handler,
 		},
+		{
+			MethodName: "MarkRead",
+			Handler:    _MessageService_MarkRead_Handler,
+		},
+		{
+			MethodName: "GetUnreadCount",
+			Handler:    _MessageService_GetUnreadCount_Handler,
+		},
+		{
+			MethodName: "ListConversations",
+			Handler:    _MessageService_ListConversations_Handler,
+		},
+		{
+			MethodName: "CreateGroup",
+			Handler:    _MessageService_CreateGroup_Handler,
+		},
+		{
+			MethodName: "RenameGroup",
+			Handler:    _MessageService_RenameGroup_Handler,
+		},
+		{
+			MethodName: "AddGroupMembers",
+			Handler:    _MessageService_AddGroupMembers_Handler,
+		},
+		{
+			MethodName: "RemoveGroupMember",
+			Handler:    _MessageService_RemoveGroupMember_Handler,
+		},
+		{
+			MethodName: "LeaveGroup",
+			Handler:    _MessageService_LeaveGroup_Handler,
+		},
+		{
+			MethodName: "GroupMessageAction",
+			Handler:    _MessageService_GroupMessageAction_Handler,
+		},
+		{
+			MethodName: "GetGroupMessageList",
+			Handler:    _MessageService_GetGroupMessageList_Handler,
+		},
+		{
+			MethodName: "GetLatestMessages",
+			Handler:    _MessageService_GetLatestMessages_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "message/service/v1/message.proto",
@@ -20,18 +20,58 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationMessageServiceAddGroupMembers = "/message.service.v1.messageService/AddGroupMembers"
+const OperationMessageServiceCreateGroup = "/message.service.v1.messageService/CreateGroup"
+const OperationMessageServiceGetGroupMessageList = "/message.service.v1.messageService/GetGroupMessageList"
 const OperationMessageServiceGetMessageList = "/message.service.v1.messageService/GetMessageList"
+const OperationMessageServiceGetUnreadCount = "/message.service.v1.messageService/GetUnreadCount"
+const OperationMessageServiceGroupMessageAction = "/message.service.v1.messageService/GroupMessageAction"
+const OperationMessageServiceLeaveGroup = "/message.service.v1.messageService/LeaveGroup"
+const OperationMessageServiceListConversations = "/message.service.v1.messageService/ListConversations"
+const OperationMessageServiceMarkRead = "/message.service.v1.messageService/MarkRead"
 const OperationMessageServiceMessageAction = "/message.service.v1.messageService/MessageAction"
+const OperationMessageServiceRemoveGroupMember = "/message.service.v1.messageService/RemoveGroupMember"
+const OperationMessageServiceRenameGroup = "/message.service.v1.messageService/RenameGroup"
 
 type MessageServiceHTTPServer interface {
+	// AddGroupMembers 邀请用户加入群聊
+	AddGroupMembers(context.Context, *AddGroupMembersRequest) (*GroupActionReply, error)
+	// CreateGroup 创建群聊
+	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupReply, error)
+	// GetGroupMessageList 按从新到旧分页获取群消息
+	GetGroupMessageList(context.Context, *GroupMessageListRequest) (*GroupMessageListReply, error)
 	GetMessageList(context.Context, *MessageListRequest) (*MessageListReply, error)
+	// GetUnreadCount 获取每个会话和全部会话的未读消息数量
+	GetUnreadCount(context.Context, *UnreadCountRequest) (*UnreadCountReply, error)
+	// GroupMessageAction 发送群消息
+	GroupMessageAction(context.Context, *GroupMessageActionRequest) (*GroupActionReply, error)
+	// LeaveGroup 退出群聊
+	LeaveGroup(context.Context, *LeaveGroupRequest) (*GroupActionReply, error)
+	// ListConversations 按最近消息时间倒序分页获取会话列表
+	ListConversations(context.Context, *ConversationListRequest) (*ConversationListReply, error)
+	// MarkRead 将与对方的聊天记录标记为已读，并向对方发送已读回执
+	MarkRead(context.Context, *MarkReadRequest) (*MarkReadReply, error)
 	MessageAction(context.Context, *MessageActionRequest) (*MessageActionReply, error)
+	// RemoveGroupMember 将用户移出群聊
+	RemoveGroupMember(context.Context, *RemoveGroupMemberRequest) (*GroupActionReply, error)
+	// RenameGroup 修改群名称
+	RenameGroup(context.Context, *RenameGroupRequest) (*GroupActionReply, error)
 }
 
 func RegisterMessageServiceHTTPServer(s *http.Server, srv MessageServiceHTTPServer) {
 	r := s.Route("/")
 	r.GET("/douyin/message/chat", _MessageService_GetMessageList0_HTTP_Handler(srv))
 	r.POST("/douyin/message/action", _MessageService_MessageAction0_HTTP_Handler(srv))
+	r.POST("/douyin/message/read", _MessageService_MarkRead0_HTTP_Handler(srv))
+	r.GET("/douyin/message/unread", _MessageService_GetUnreadCount0_HTTP_Handler(srv))
+	r.GET("/douyin/message/conversation/list", _MessageService_ListConversations0_HTTP_Handler(srv))
+	r.POST("/douyin/message/group/create", _MessageService_CreateGroup0_HTTP_Handler(srv))
+	r.POST("/douyin/message/group/rename", _MessageService_RenameGroup0_HTTP_Handler(srv))
+	r.POST("/douyin/message/group/member/add", _MessageService_AddGroupMembers0_HTTP_Handler(srv))
+	r.POST("/douyin/message/group/member/remove", _MessageService_RemoveGroupMember0_HTTP_Handler(srv))
+	r.POST("/douyin/message/group/leave", _MessageService_LeaveGroup0_HTTP_Handler(srv))
+	r.POST("/douyin/message/group/action", _MessageService_GroupMessageAction0_HTTP_Handler(srv))
+	r.GET("/douyin/message/group/chat", _MessageService_GetGroupMessageList0_HTTP_Handler(srv))
 }
 
 func _MessageService_GetMessageList0_HTTP_Handler(srv MessageServiceHTTPServer) func(ctx http.Context) error {
@@ -75,9 +115,230 @@ func _MessageService_MessageAction0_HTTP_Handler(srv MessageServiceHTTPServer) f
 	}
 }
 
+func _MessageService_MarkRead0_HTTP_Handler(srv MessageServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MarkReadRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMessageServiceMarkRead)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MarkRead(ctx, req.(*MarkReadRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MarkReadReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MessageService_GetUnreadCount0_HTTP_Handler(srv MessageServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnreadCountRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMessageServiceGetUnreadCount)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUnreadCount(ctx, req.(*UnreadCountRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnreadCountReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MessageService_ListConversations0_HTTP_Handler(srv MessageServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ConversationListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMessageServiceListConversations)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListConversations(ctx, req.(*ConversationListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ConversationListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MessageService_CreateGroup0_HTTP_Handler(srv MessageServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateGroupRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMessageServiceCreateGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateGroup(ctx, req.(*CreateGroupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateGroupReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MessageService_RenameGroup0_HTTP_Handler(srv MessageServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RenameGroupRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMessageServiceRenameGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RenameGroup(ctx, req.(*RenameGroupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GroupActionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MessageService_AddGroupMembers0_HTTP_Handler(srv MessageServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddGroupMembersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMessageServiceAddGroupMembers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddGroupMembers(ctx, req.(*AddGroupMembersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GroupActionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MessageService_RemoveGroupMember0_HTTP_Handler(srv MessageServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveGroupMemberRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMessageServiceRemoveGroupMember)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveGroupMember(ctx, req.(*RemoveGroupMemberRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GroupActionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MessageService_LeaveGroup0_HTTP_Handler(srv MessageServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LeaveGroupRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMessageServiceLeaveGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LeaveGroup(ctx, req.(*LeaveGroupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GroupActionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MessageService_GroupMessageAction0_HTTP_Handler(srv MessageServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GroupMessageActionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMessageServiceGroupMessageAction)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GroupMessageAction(ctx, req.(*GroupMessageActionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GroupActionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MessageService_GetGroupMessageList0_HTTP_Handler(srv MessageServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GroupMessageListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMessageServiceGetGroupMessageList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetGroupMessageList(ctx, req.(*GroupMessageListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GroupMessageListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type MessageServiceHTTPClient interface {
+	AddGroupMembers(ctx context.Context, req *AddGroupMembersRequest, opts ...http.CallOption) (rsp *GroupActionReply, err error)
+	CreateGroup(ctx context.Context, req *CreateGroupRequest, opts ...http.CallOption) (rsp *CreateGroupReply, err error)
+	GetGroupMessageList(ctx context.Context, req *GroupMessageListRequest, opts ...http.CallOption) (rsp *GroupMessageListReply, err error)
 	GetMessageList(ctx context.Context, req *MessageListRequest, opts ...http.CallOption) (rsp *MessageListReply, err error)
+	GetUnreadCount(ctx context.Context, req *UnreadCountRequest, opts ...http.CallOption) (rsp *UnreadCountReply, err error)
+	GroupMessageAction(ctx context.Context, req *GroupMessageActionRequest, opts ...http.CallOption) (rsp *GroupActionReply, err error)
+	LeaveGroup(ctx context.Context, req *LeaveGroupRequest, opts ...http.CallOption) (rsp *GroupActionReply, err error)
+	ListConversations(ctx context.Context, req *ConversationListRequest, opts ...http.CallOption) (rsp *ConversationListReply, err error)
+	MarkRead(ctx context.Context, req *MarkReadRequest, opts ...http.CallOption) (rsp *MarkReadReply, err error)
 	MessageAction(ctx context.Context, req *MessageActionRequest, opts ...http.CallOption) (rsp *MessageActionReply, err error)
+	RemoveGroupMember(ctx context.Context, req *RemoveGroupMemberRequest, opts ...http.CallOption) (rsp *GroupActionReply, err error)
+	RenameGroup(ctx context.Context, req *RenameGroupRequest, opts ...http.CallOption) (rsp *GroupActionReply, err error)
 }
 
 type MessageServiceHTTPClientImpl struct {
@@ -88,6 +349,45 @@ func NewMessageServiceHTTPClient(client *http.Client) MessageServiceHTTPClient {
 	return &MessageServiceHTTPClientImpl{client}
 }
 
+func (c *MessageServiceHTTPClientImpl) AddGroupMembers(ctx context.Context, in *AddGroupMembersRequest, opts ...http.CallOption) (*GroupActionReply, error) {
+	var out GroupActionReply
+	pattern := "/douyin/message/group/member/add"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMessageServiceAddGroupMembers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *MessageServiceHTTPClientImpl) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...http.CallOption) (*CreateGroupReply, error) {
+	var out CreateGroupReply
+	pattern := "/douyin/message/group/create"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMessageServiceCreateGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *MessageServiceHTTPClientImpl) GetGroupMessageList(ctx context.Context, in *GroupMessageListRequest, opts ...http.CallOption) (*GroupMessageListReply, error) {
+	var out GroupMessageListReply
+	pattern := "/douyin/message/group/chat"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMessageServiceGetGroupMessageList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *MessageServiceHTTPClientImpl) GetMessageList(ctx context.Context, in *MessageListRequest, opts ...http.CallOption) (*MessageListReply, error) {
 	var out MessageListReply
 	pattern := "/douyin/message/chat"
@@ -101,6 +401,71 @@ func (c *MessageServiceHTTPClientImpl) GetMessageList(ctx context.Context, in *M
 	return &out, err
 }
 
+func (c *MessageServiceHTTPClientImpl) GetUnreadCount(ctx context.Context, in *UnreadCountRequest, opts ...http.CallOption) (*UnreadCountReply, error) {
+	var out UnreadCountReply
+	pattern := "/douyin/message/unread"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMessageServiceGetUnreadCount))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *MessageServiceHTTPClientImpl) GroupMessageAction(ctx context.Context, in *GroupMessageActionRequest, opts ...http.CallOption) (*GroupActionReply, error) {
+	var out GroupActionReply
+	pattern := "/douyin/message/group/action"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMessageServiceGroupMessageAction))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *MessageServiceHTTPClientImpl) LeaveGroup(ctx context.Context, in *LeaveGroupRequest, opts ...http.CallOption) (*GroupActionReply, error) {
+	var out GroupActionReply
+	pattern := "/douyin/message/group/leave"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMessageServiceLeaveGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *MessageServiceHTTPClientImpl) ListConversations(ctx context.Context, in *ConversationListRequest, opts ...http.CallOption) (*ConversationListReply, error) {
+	var out ConversationListReply
+	pattern := "/douyin/message/conversation/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMessageServiceListConversations))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *MessageServiceHTTPClientImpl) MarkRead(ctx context.Context, in *MarkReadRequest, opts ...http.CallOption) (*MarkReadReply, error) {
+	var out MarkReadReply
+	pattern := "/douyin/message/read"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMessageServiceMarkRead))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *MessageServiceHTTPClientImpl) MessageAction(ctx context.Context, in *MessageActionRequest, opts ...http.CallOption) (*MessageActionReply, error) {
 	var out MessageActionReply
 	pattern := "/douyin/message/action"
@@ -113,3 +478,29 @@ func (c *MessageServiceHTTPClientImpl) MessageAction(ctx context.Context, in *Me
 	}
 	return &out, err
 }
+
+func (c *MessageServiceHTTPClientImpl) RemoveGroupMember(ctx context.Context, in *RemoveGroupMemberRequest, opts ...http.CallOption) (*GroupActionReply, error) {
+	var out GroupActionReply
+	pattern := "/douyin/message/group/member/remove"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMessageServiceRemoveGroupMember))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *MessageServiceHTTPClientImpl) RenameGroup(ctx context.Context, in *RenameGroupRequest, opts ...http.CallOption) (*GroupActionReply, error) {
+	var out GroupActionReply
+	pattern := "/douyin/message/group/rename"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMessageServiceRenameGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
@@ -237,6 +237,2145 @@ var _ interface {
 	ErrorName() string
 } = IsFollowReplyValidationError{}
 
+// Validate checks the field values on IsBlockedRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IsBlockedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IsBlockedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IsBlockedRequestMultiError, or nil if none found.
+func (m *IsBlockedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsBlockedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return IsBlockedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IsBlockedRequestMultiError is an error wrapping multiple validation errors
+// returned by IsBlockedRequest.ValidateAll() if the designated constraints
+// aren't met.
+type IsBlockedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsBlockedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IsBlockedRequestMultiError) AllErrors() []error { return m }
+
+// IsBlockedRequestValidationError is the validation error returned by
+// IsBlockedRequest.Validate if the designated constraints aren't met.
+type IsBlockedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsBlockedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsBlockedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsBlockedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsBlockedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsBlockedRequestValidationError) ErrorName() string { return "IsBlockedRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IsBlockedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsBlockedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsBlockedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsBlockedRequestValidationError{}
+
+// Validate checks the field values on IsBlockedReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IsBlockedReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IsBlockedReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IsBlockedReplyMultiError,
+// or nil if none found.
+func (m *IsBlockedReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsBlockedReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return IsBlockedReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// IsBlockedReplyMultiError is an error wrapping multiple validation errors
+// returned by IsBlockedReply.ValidateAll() if the designated constraints
+// aren't met.
+type IsBlockedReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsBlockedReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IsBlockedReplyMultiError) AllErrors() []error { return m }
+
+// IsBlockedReplyValidationError is the validation error returned by
+// IsBlockedReply.Validate if the designated constraints aren't met.
+type IsBlockedReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsBlockedReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsBlockedReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsBlockedReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsBlockedReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsBlockedReplyValidationError) ErrorName() string { return "IsBlockedReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IsBlockedReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsBlockedReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsBlockedReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsBlockedReplyValidationError{}
+
+// Validate checks the field values on MuteListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MuteListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MuteListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MuteListRequestMultiError, or nil if none found.
+func (m *MuteListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MuteListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return MuteListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MuteListRequestMultiError is an error wrapping multiple validation errors
+// returned by MuteListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MuteListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MuteListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MuteListRequestMultiError) AllErrors() []error { return m }
+
+// MuteListRequestValidationError is the validation error returned by
+// MuteListRequest.Validate if the designated constraints aren't met.
+type MuteListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MuteListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MuteListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MuteListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MuteListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MuteListRequestValidationError) ErrorName() string { return "MuteListRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MuteListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMuteListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MuteListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MuteListRequestValidationError{}
+
+// Validate checks the field values on MuteListReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MuteListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MuteListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MuteListReplyMultiError, or
+// nil if none found.
+func (m *MuteListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MuteListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return MuteListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MuteListReplyMultiError is an error wrapping multiple validation errors
+// returned by MuteListReply.ValidateAll() if the designated constraints
+// aren't met.
+type MuteListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MuteListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MuteListReplyMultiError) AllErrors() []error { return m }
+
+// MuteListReplyValidationError is the validation error returned by
+// MuteListReply.Validate if the designated constraints aren't met.
+type MuteListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MuteListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MuteListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MuteListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MuteListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MuteListReplyValidationError) ErrorName() string { return "MuteListReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MuteListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMuteListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MuteListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MuteListReplyValidationError{}
+
+// Validate checks the field values on BlockActionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BlockActionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockActionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BlockActionRequestMultiError, or nil if none found.
+func (m *BlockActionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockActionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := BlockActionRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetToUserId() <= 0 {
+		err := BlockActionRequestValidationError{
+			field:  "ToUserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ActionType
+
+	if len(errors) > 0 {
+		return BlockActionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockActionRequestMultiError is an error wrapping multiple validation errors
+// returned by BlockActionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BlockActionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockActionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockActionRequestMultiError) AllErrors() []error { return m }
+
+// BlockActionRequestValidationError is the validation error returned by
+// BlockActionRequest.Validate if the designated constraints aren't met.
+type BlockActionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockActionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockActionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockActionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockActionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockActionRequestValidationError) ErrorName() string {
+	return "BlockActionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BlockActionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockActionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockActionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockActionRequestValidationError{}
+
+// Validate checks the field values on BlockActionReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BlockActionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockActionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BlockActionReplyMultiError, or nil if none found.
+func (m *BlockActionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockActionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	if len(errors) > 0 {
+		return BlockActionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockActionReplyMultiError is an error wrapping multiple validation errors
+// returned by BlockActionReply.ValidateAll() if the designated constraints
+// aren't met.
+type BlockActionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockActionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockActionReplyMultiError) AllErrors() []error { return m }
+
+// BlockActionReplyValidationError is the validation error returned by
+// BlockActionReply.Validate if the designated constraints aren't met.
+type BlockActionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockActionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockActionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockActionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockActionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockActionReplyValidationError) ErrorName() string { return "BlockActionReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlockActionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockActionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockActionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockActionReplyValidationError{}
+
+// Validate checks the field values on MuteActionRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MuteActionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MuteActionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MuteActionRequestMultiError, or nil if none found.
+func (m *MuteActionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MuteActionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := MuteActionRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetToUserId() <= 0 {
+		err := MuteActionRequestValidationError{
+			field:  "ToUserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ActionType
+
+	if len(errors) > 0 {
+		return MuteActionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MuteActionRequestMultiError is an error wrapping multiple validation errors
+// returned by MuteActionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MuteActionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MuteActionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MuteActionRequestMultiError) AllErrors() []error { return m }
+
+// MuteActionRequestValidationError is the validation error returned by
+// MuteActionRequest.Validate if the designated constraints aren't met.
+type MuteActionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MuteActionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MuteActionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MuteActionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MuteActionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MuteActionRequestValidationError) ErrorName() string {
+	return "MuteActionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MuteActionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMuteActionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MuteActionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MuteActionRequestValidationError{}
+
+// Validate checks the field values on MuteActionReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MuteActionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MuteActionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MuteActionReplyMultiError, or nil if none found.
+func (m *MuteActionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MuteActionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	if len(errors) > 0 {
+		return MuteActionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MuteActionReplyMultiError is an error wrapping multiple validation errors
+// returned by MuteActionReply.ValidateAll() if the designated constraints
+// aren't met.
+type MuteActionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MuteActionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MuteActionReplyMultiError) AllErrors() []error { return m }
+
+// MuteActionReplyValidationError is the validation error returned by
+// MuteActionReply.Validate if the designated constraints aren't met.
+type MuteActionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MuteActionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MuteActionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MuteActionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MuteActionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MuteActionReplyValidationError) ErrorName() string { return "MuteActionReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MuteActionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMuteActionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MuteActionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MuteActionReplyValidationError{}
+
+// Validate checks the field values on BlockListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BlockListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BlockListRequestMultiError, or nil if none found.
+func (m *BlockListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := BlockListRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BlockListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockListRequestMultiError is an error wrapping multiple validation errors
+// returned by BlockListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BlockListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockListRequestMultiError) AllErrors() []error { return m }
+
+// BlockListRequestValidationError is the validation error returned by
+// BlockListRequest.Validate if the designated constraints aren't met.
+type BlockListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockListRequestValidationError) ErrorName() string { return "BlockListRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlockListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockListRequestValidationError{}
+
+// Validate checks the field values on BlockListReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BlockListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BlockListReplyMultiError,
+// or nil if none found.
+func (m *BlockListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	for idx, item := range m.GetUserList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BlockListReplyValidationError{
+						field:  fmt.Sprintf("UserList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BlockListReplyValidationError{
+						field:  fmt.Sprintf("UserList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BlockListReplyValidationError{
+					field:  fmt.Sprintf("UserList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BlockListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockListReplyMultiError is an error wrapping multiple validation errors
+// returned by BlockListReply.ValidateAll() if the designated constraints
+// aren't met.
+type BlockListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockListReplyMultiError) AllErrors() []error { return m }
+
+// BlockListReplyValidationError is the validation error returned by
+// BlockListReply.Validate if the designated constraints aren't met.
+type BlockListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockListReplyValidationError) ErrorName() string { return "BlockListReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlockListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockListReplyValidationError{}
+
+// Validate checks the field values on FollowRequestActionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FollowRequestActionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowRequestActionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FollowRequestActionRequestMultiError, or nil if none found.
+func (m *FollowRequestActionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowRequestActionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := FollowRequestActionRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFromUserId() <= 0 {
+		err := FollowRequestActionRequestValidationError{
+			field:  "FromUserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ActionType
+
+	if len(errors) > 0 {
+		return FollowRequestActionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowRequestActionRequestMultiError is an error wrapping multiple
+// validation errors returned by FollowRequestActionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type FollowRequestActionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowRequestActionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowRequestActionRequestMultiError) AllErrors() []error { return m }
+
+// FollowRequestActionRequestValidationError is the validation error returned
+// by FollowRequestActionRequest.Validate if the designated constraints aren't met.
+type FollowRequestActionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowRequestActionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowRequestActionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowRequestActionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowRequestActionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowRequestActionRequestValidationError) ErrorName() string {
+	return "FollowRequestActionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FollowRequestActionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowRequestActionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowRequestActionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowRequestActionRequestValidationError{}
+
+// Validate checks the field values on FollowRequestActionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FollowRequestActionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowRequestActionReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FollowRequestActionReplyMultiError, or nil if none found.
+func (m *FollowRequestActionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowRequestActionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	if len(errors) > 0 {
+		return FollowRequestActionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowRequestActionReplyMultiError is an error wrapping multiple validation
+// errors returned by FollowRequestActionReply.ValidateAll() if the designated
+// constraints aren't met.
+type FollowRequestActionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowRequestActionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowRequestActionReplyMultiError) AllErrors() []error { return m }
+
+// FollowRequestActionReplyValidationError is the validation error returned by
+// FollowRequestActionReply.Validate if the designated constraints aren't met.
+type FollowRequestActionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowRequestActionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowRequestActionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowRequestActionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowRequestActionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowRequestActionReplyValidationError) ErrorName() string {
+	return "FollowRequestActionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FollowRequestActionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowRequestActionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowRequestActionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowRequestActionReplyValidationError{}
+
+// Validate checks the field values on FollowRequestListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FollowRequestListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowRequestListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FollowRequestListRequestMultiError, or nil if none found.
+func (m *FollowRequestListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowRequestListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := FollowRequestListRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FollowRequestListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowRequestListRequestMultiError is an error wrapping multiple validation
+// errors returned by FollowRequestListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FollowRequestListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowRequestListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowRequestListRequestMultiError) AllErrors() []error { return m }
+
+// FollowRequestListRequestValidationError is the validation error returned by
+// FollowRequestListRequest.Validate if the designated constraints aren't met.
+type FollowRequestListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowRequestListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowRequestListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowRequestListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowRequestListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowRequestListRequestValidationError) ErrorName() string {
+	return "FollowRequestListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FollowRequestListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowRequestListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowRequestListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowRequestListRequestValidationError{}
+
+// Validate checks the field values on FollowRequestListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FollowRequestListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowRequestListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FollowRequestListReplyMultiError, or nil if none found.
+func (m *FollowRequestListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowRequestListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	for idx, item := range m.GetUserList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FollowRequestListReplyValidationError{
+						field:  fmt.Sprintf("UserList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FollowRequestListReplyValidationError{
+						field:  fmt.Sprintf("UserList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FollowRequestListReplyValidationError{
+					field:  fmt.Sprintf("UserList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FollowRequestListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowRequestListReplyMultiError is an error wrapping multiple validation
+// errors returned by FollowRequestListReply.ValidateAll() if the designated
+// constraints aren't met.
+type FollowRequestListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowRequestListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowRequestListReplyMultiError) AllErrors() []error { return m }
+
+// FollowRequestListReplyValidationError is the validation error returned by
+// FollowRequestListReply.Validate if the designated constraints aren't met.
+type FollowRequestListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowRequestListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowRequestListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowRequestListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowRequestListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowRequestListReplyValidationError) ErrorName() string {
+	return "FollowRequestListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FollowRequestListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowRequestListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowRequestListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowRequestListReplyValidationError{}
+
+// Validate checks the field values on CommonFollowListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommonFollowListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommonFollowListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommonFollowListRequestMultiError, or nil if none found.
+func (m *CommonFollowListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommonFollowListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := CommonFollowListRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetToUserId() <= 0 {
+		err := CommonFollowListRequestValidationError{
+			field:  "ToUserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCount() > 50 {
+		err := CommonFollowListRequestValidationError{
+			field:  "Count",
+			reason: "value must be less than or equal to 50",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CommonFollowListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommonFollowListRequestMultiError is an error wrapping multiple validation
+// errors returned by CommonFollowListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CommonFollowListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommonFollowListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommonFollowListRequestMultiError) AllErrors() []error { return m }
+
+// CommonFollowListRequestValidationError is the validation error returned by
+// CommonFollowListRequest.Validate if the designated constraints aren't met.
+type CommonFollowListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommonFollowListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommonFollowListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommonFollowListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommonFollowListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommonFollowListRequestValidationError) ErrorName() string {
+	return "CommonFollowListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommonFollowListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommonFollowListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommonFollowListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommonFollowListRequestValidationError{}
+
+// Validate checks the field values on CommonFollowListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommonFollowListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommonFollowListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommonFollowListReplyMultiError, or nil if none found.
+func (m *CommonFollowListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommonFollowListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	// no validation rules for CommonCount
+
+	for idx, item := range m.GetUserList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CommonFollowListReplyValidationError{
+						field:  fmt.Sprintf("UserList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CommonFollowListReplyValidationError{
+						field:  fmt.Sprintf("UserList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CommonFollowListReplyValidationError{
+					field:  fmt.Sprintf("UserList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CommonFollowListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommonFollowListReplyMultiError is an error wrapping multiple validation
+// errors returned by CommonFollowListReply.ValidateAll() if the designated
+// constraints aren't met.
+type CommonFollowListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommonFollowListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommonFollowListReplyMultiError) AllErrors() []error { return m }
+
+// CommonFollowListReplyValidationError is the validation error returned by
+// CommonFollowListReply.Validate if the designated constraints aren't met.
+type CommonFollowListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommonFollowListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommonFollowListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommonFollowListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommonFollowListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommonFollowListReplyValidationError) ErrorName() string {
+	return "CommonFollowListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommonFollowListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommonFollowListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommonFollowListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommonFollowListReplyValidationError{}
+
+// Validate checks the field values on SuggestUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuggestUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuggestUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuggestUsersRequestMultiError, or nil if none found.
+func (m *SuggestUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuggestUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := SuggestUsersRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCount() > 50 {
+		err := SuggestUsersRequestValidationError{
+			field:  "Count",
+			reason: "value must be less than or equal to 50",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SuggestUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestUsersRequestMultiError is an error wrapping multiple validation
+// errors returned by SuggestUsersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SuggestUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestUsersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestUsersRequestMultiError) AllErrors() []error { return m }
+
+// SuggestUsersRequestValidationError is the validation error returned by
+// SuggestUsersRequest.Validate if the designated constraints aren't met.
+type SuggestUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestUsersRequestValidationError) ErrorName() string {
+	return "SuggestUsersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuggestUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestUsersRequestValidationError{}
+
+// Validate checks the field values on SuggestUsersReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SuggestUsersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuggestUsersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuggestUsersReplyMultiError, or nil if none found.
+func (m *SuggestUsersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuggestUsersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	for idx, item := range m.GetUserList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SuggestUsersReplyValidationError{
+						field:  fmt.Sprintf("UserList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SuggestUsersReplyValidationError{
+						field:  fmt.Sprintf("UserList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SuggestUsersReplyValidationError{
+					field:  fmt.Sprintf("UserList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SuggestUsersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestUsersReplyMultiError is an error wrapping multiple validation errors
+// returned by SuggestUsersReply.ValidateAll() if the designated constraints
+// aren't met.
+type SuggestUsersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestUsersReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestUsersReplyMultiError) AllErrors() []error { return m }
+
+// SuggestUsersReplyValidationError is the validation error returned by
+// SuggestUsersReply.Validate if the designated constraints aren't met.
+type SuggestUsersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestUsersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestUsersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestUsersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestUsersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestUsersReplyValidationError) ErrorName() string {
+	return "SuggestUsersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuggestUsersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestUsersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestUsersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestUsersReplyValidationError{}
+
 // Validate checks the field values on RelationActionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -389,6 +2528,8 @@ func (m *RelationActionReply) validate(all bool) error {
 
 	// no validation rules for StatusMsg
 
+	// no validation rules for IsPending
+
 	if len(errors) > 0 {
 		return RelationActionReplyMultiError(errors)
 	}
@@ -495,6 +2636,19 @@ func (m *RelationFollowerListRequest) validate(all bool) error {
 
 	// no validation rules for Token
 
+	// no validation rules for Cursor
+
+	if m.GetCount() > 100 {
+		err := RelationFollowerListRequestValidationError{
+			field:  "Count",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return RelationFollowerListRequestMultiError(errors)
 	}
@@ -636,6 +2790,8 @@ func (m *RelationFollowerListReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextCursor
+
 	if len(errors) > 0 {
 		return RelationFollowerListReplyMultiError(errors)
 	}
@@ -1385,6 +3541,8 @@ func (m *FriendUser) validate(all bool) error {
 
 	// no validation rules for MsgType
 
+	// no validation rules for UnreadCount
+
 	if len(errors) > 0 {
 		return FriendUserMultiError(errors)
 	}
@@ -24,7 +24,16 @@ const (
 	RelationService_GetFollowRelationList_FullMethodName   = "/relation.service.v1.RelationService/GetFollowRelationList"
 	RelationService_RelationAction_FullMethodName          = "/relation.service.v1.RelationService/RelationAction"
 	RelationService_GetFriendRelationList_FullMethodName   = "/relation.service.v1.RelationService/GetFriendRelationList"
+	RelationService_BlockAction_FullMethodName             = "/relation.service.v1.RelationService/BlockAction"
+	RelationService_MuteAction_FullMethodName              = "/relation.service.v1.RelationService/MuteAction"
+	RelationService_GetBlockList_FullMethodName            = "/relation.service.v1.RelationService/GetBlockList"
+	RelationService_FollowRequestAction_FullMethodName     = "/relation.service.v1.RelationService/FollowRequestAction"
+	RelationService_GetFollowRequestList_FullMethodName    = "/relation.service.v1.RelationService/GetFollowRequestList"
+	RelationService_SuggestUsers_FullMethodName            = "/relation.service.v1.RelationService/SuggestUsers"
+	RelationService_GetCommonFollowList_FullMethodName     = "/relation.service.v1.RelationService/GetCommonFollowList"
 	RelationService_IsFollow_FullMethodName                = "/relation.service.v1.RelationService/IsFollow"
+	RelationService_IsBlocked_FullMethodName               = "/relation.service.v1.RelationService/IsBlocked"
+	RelationService_GetMuteList_FullMethodName             = "/relation.service.v1.RelationService/GetMuteList"
 )
 
 // RelationServiceClient is the client API for RelationService service.
@@ -39,8 +48,26 @@ type RelationServiceClient interface {
 	RelationAction(ctx context.Context, in *RelationActionRequest, opts ...grpc.CallOption) (*RelationActionReply, error)
 	// 获取好友列表(客户端)
 	GetFriendRelationList(ctx context.Context, in *RelationFriendListRequest, opts ...grpc.CallOption) (*RelationFriendListReply, error)
+	// 屏蔽或取消屏蔽用户(客户端)
+	BlockAction(ctx context.Context, in *BlockActionRequest, opts ...grpc.CallOption) (*BlockActionReply, error)
+	// 静音或取消静音用户(客户端)
+	MuteAction(ctx context.Context, in *MuteActionRequest, opts ...grpc.CallOption) (*MuteActionReply, error)
+	// 获取屏蔽列表(客户端)
+	GetBlockList(ctx context.Context, in *BlockListRequest, opts ...grpc.CallOption) (*BlockListReply, error)
+	// 同意或拒绝关注请求(客户端)
+	FollowRequestAction(ctx context.Context, in *FollowRequestActionRequest, opts ...grpc.CallOption) (*FollowRequestActionReply, error)
+	// 获取待处理的关注请求列表(客户端)
+	GetFollowRequestList(ctx context.Context, in *FollowRequestListRequest, opts ...grpc.CallOption) (*FollowRequestListReply, error)
+	// 获取可能认识的人(客户端)
+	SuggestUsers(ctx context.Context, in *SuggestUsersRequest, opts ...grpc.CallOption) (*SuggestUsersReply, error)
+	// 获取自己关注的人中同样关注了对方的用户(客户端)
+	GetCommonFollowList(ctx context.Context, in *CommonFollowListRequest, opts ...grpc.CallOption) (*CommonFollowListReply, error)
 	// 根据userId和toUserId判断是否关注(user)
 	IsFollow(ctx context.Context, in *IsFollowRequest, opts ...grpc.CallOption) (*IsFollowReply, error)
+	// 判断toUserId中的用户是否屏蔽了userId(message、comment)
+	IsBlocked(ctx context.Context, in *IsBlockedRequest, opts ...grpc.CallOption) (*IsBlockedReply, error)
+	// 获取用户静音的用户id列表(publish)
+	GetMuteList(ctx context.Context, in *MuteListRequest, opts ...grpc.CallOption) (*MuteListReply, error)
 }
 
 type relationServiceClient struct {
@@ -87,6 +114,69 @@ func (c *relationServiceClient) GetFriendRelationList(ctx context.Context, in *R
 	return out, nil
 }
 
+func (c *relationServiceClient) BlockAction(ctx context.Context, in *BlockActionRequest, opts ...grpc.CallOption) (*BlockActionReply, error) {
+	out := new(BlockActionReply)
+	err := c.cc.Invoke(ctx, RelationService_BlockAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) MuteAction(ctx context.Context, in *MuteActionRequest, opts ...grpc.CallOption) (*MuteActionReply, error) {
+	out := new(MuteActionReply)
+	err := c.cc.Invoke(ctx, RelationService_MuteAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) GetBlockList(ctx context.Context, in *BlockListRequest, opts ...grpc.CallOption) (*BlockListReply, error) {
+	out := new(BlockListReply)
+	err := c.cc.Invoke(ctx, RelationService_GetBlockList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) FollowRequestAction(ctx context.Context, in *FollowRequestActionRequest, opts ...grpc.CallOption) (*FollowRequestActionReply, error) {
+	out := new(FollowRequestActionReply)
+	err := c.cc.Invoke(ctx, RelationService_FollowRequestAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) GetFollowRequestList(ctx context.Context, in *FollowRequestListRequest, opts ...grpc.CallOption) (*FollowRequestListReply, error) {
+	out := new(FollowRequestListReply)
+	err := c.cc.Invoke(ctx, RelationService_GetFollowRequestList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) SuggestUsers(ctx context.Context, in *SuggestUsersRequest, opts ...grpc.CallOption) (*SuggestUsersReply, error) {
+	out := new(SuggestUsersReply)
+	err := c.cc.Invoke(ctx, RelationService_SuggestUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) GetCommonFollowList(ctx context.Context, in *CommonFollowListRequest, opts ...grpc.CallOption) (*CommonFollowListReply, error) {
+	out := new(CommonFollowListReply)
+	err := c.cc.Invoke(ctx, RelationService_GetCommonFollowList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *relationServiceClient) IsFollow(ctx context.Context, in *IsFollowRequest, opts ...grpc.CallOption) (*IsFollowReply, error) {
 	out := new(IsFollowReply)
 	err := c.cc.Invoke(ctx, RelationService_IsFollow_FullMethodName, in, out, opts...)
@@ -96,6 +186,24 @@ func (c *relationServiceClient) IsFollow(ctx context.Context, in *IsFollowReques
 	return out, nil
 }
 
+func (c *relationServiceClient) IsBlocked(ctx context.Context, in *IsBlockedRequest, opts ...grpc.CallOption) (*IsBlockedReply, error) {
+	out := new(IsBlockedReply)
+	err := c.cc.Invoke(ctx, RelationService_IsBlocked_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) GetMuteList(ctx context.Context, in *MuteListRequest, opts ...grpc.CallOption) (*MuteListReply, error) {
+	out := new(MuteListReply)
+	err := c.cc.Invoke(ctx, RelationService_GetMuteList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RelationServiceServer is the server API for RelationService service.
 // All implementations must embed UnimplementedRelationServiceServer
 // for forward compatibility
@@ -108,8 +216,26 @@ type RelationServiceServer interface {
 	RelationAction(context.Context, *RelationActionRequest) (*RelationActionReply, error)
 	// 获取好友列表(客户端)
 	GetFriendRelationList(context.Context, *RelationFriendListRequest) (*RelationFriendListReply, error)
+	// 屏蔽或取消屏蔽用户(客户端)
+	BlockAction(context.Context, *BlockActionRequest) (*BlockActionReply, error)
+	// 静音或取消静音用户(客户端)
+	MuteAction(context.Context, *MuteActionRequest) (*MuteActionReply, error)
+	// 获取屏蔽列表(客户端)
+	GetBlockList(context.Context, *BlockListRequest) (*BlockListReply, error)
+	// 同意或拒绝关注请求(客户端)
+	FollowRequestAction(context.Context, *FollowRequestActionRequest) (*FollowRequestActionReply, error)
+	// 获取待处理的关注请求列表(客户端)
+	GetFollowRequestList(context.Context, *FollowRequestListRequest) (*FollowRequestListReply, error)
+	// 获取可能认识的人(客户端)
+	SuggestUsers(context.Context, *SuggestUsersRequest) (*SuggestUsersReply, error)
+	// 获取自己关注的人中同样关注了对方的用户(客户端)
+	GetCommonFollowList(context.Context, *CommonFollowListRequest) (*CommonFollowListReply, error)
 	// 根据userId和toUserId判断是否关注(user)
 	IsFollow(context.Context, *IsFollowRequest) (*IsFollowReply, error)
+	// 判断toUserId中的用户是否屏蔽了userId(message、comment)
+	IsBlocked(context.Context, *IsBlockedRequest) (*IsBlockedReply, error)
+	// 获取用户静音的用户id列表(publish)
+	GetMuteList(context.Context, *MuteListRequest) (*MuteListReply, error)
 	mustEmbedUnimplementedRelationServiceServer()
 }
 
@@ -129,9 +255,36 @@ func (UnimplementedRelationServiceServer) RelationAction(context.Context, *Relat
 func (UnimplementedRelationServiceServer) GetFriendRelationList(context.Context, *RelationFriendListRequest) (*RelationFriendListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFriendRelationList not implemented")
 }
+func (UnimplementedRelationServiceServer) BlockAction(context.Context, *BlockActionRequest) (*BlockActionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockAction not implemented")
+}
+func (UnimplementedRelationServiceServer) MuteAction(context.Context, *MuteActionRequest) (*MuteActionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteAction not implemented")
+}
+func (UnimplementedRelationServiceServer) GetBlockList(context.Context, *BlockListRequest) (*BlockListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockList not implemented")
+}
+func (UnimplementedRelationServiceServer) FollowRequestAction(context.Context, *FollowRequestActionRequest) (*FollowRequestActionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowRequestAction not implemented")
+}
+func (UnimplementedRelationServiceServer) GetFollowRequestList(context.Context, *FollowRequestListRequest) (*FollowRequestListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowRequestList not implemented")
+}
+func (UnimplementedRelationServiceServer) SuggestUsers(context.Context, *SuggestUsersRequest) (*SuggestUsersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestUsers not implemented")
+}
+func (UnimplementedRelationServiceServer) GetCommonFollowList(context.Context, *CommonFollowListRequest) (*CommonFollowListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommonFollowList not implemented")
+}
 func (UnimplementedRelationServiceServer) IsFollow(context.Context, *IsFollowRequest) (*IsFollowReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsFollow not implemented")
 }
+func (UnimplementedRelationServiceServer) IsBlocked(context.Context, *IsBlockedRequest) (*IsBlockedReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsBlocked not implemented")
+}
+func (UnimplementedRelationServiceServer) GetMuteList(context.Context, *MuteListRequest) (*MuteListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMuteList not implemented")
+}
 func (UnimplementedRelationServiceServer) mustEmbedUnimplementedRelationServiceServer() {}
 
 // UnsafeRelationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -217,6 +370,132 @@ func _RelationService_GetFriendRelationList_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RelationService_BlockAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).BlockAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_BlockAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).BlockAction(ctx, req.(*BlockActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_MuteAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).MuteAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_MuteAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).MuteAction(ctx, req.(*MuteActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_GetBlockList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).GetBlockList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_GetBlockList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).GetBlockList(ctx, req.(*BlockListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_FollowRequestAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowRequestActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).FollowRequestAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_FollowRequestAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).FollowRequestAction(ctx, req.(*FollowRequestActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_GetFollowRequestList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowRequestListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).GetFollowRequestList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_GetFollowRequestList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).GetFollowRequestList(ctx, req.(*FollowRequestListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_SuggestUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).SuggestUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_SuggestUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).SuggestUsers(ctx, req.(*SuggestUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_GetCommonFollowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommonFollowListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).GetCommonFollowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_GetCommonFollowList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).GetCommonFollowList(ctx, req.(*CommonFollowListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RelationService_IsFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IsFollowRequest)
 	if err := dec(in); err != nil {
@@ -235,6 +514,42 @@ func _RelationService_IsFollow_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RelationService_IsBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).IsBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_IsBlocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).IsBlocked(ctx, req.(*IsBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_GetMuteList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).GetMuteList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_GetMuteList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).GetMuteList(ctx, req.(*MuteListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RelationService_ServiceDesc is the grpc.ServiceDesc for RelationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -258,10 +573,46 @@ var RelationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFriendRelationList",
 			Handler:    _RelationService_GetFriendRelationList_Handler,
 		},
+		{
+			MethodName: "BlockAction",
+			Handler:    _RelationService_BlockAction_Handler,
+		},
+		{
+			MethodName: "MuteAction",
+			Handler:    _RelationService_MuteAction_Handler,
+		},
+		{
+			MethodName: "GetBlockList",
+			Handler:    _RelationService_GetBlockList_Handler,
+		},
+		{
+			MethodName: "FollowRequestAction",
+			Handler:    _RelationService_FollowRequestAction_Handler,
+		},
+		{
+			MethodName: "GetFollowRequestList",
+			Handler:    _RelationService_GetFollowRequestList_Handler,
+		},
+		{
+			MethodName: "SuggestUsers",
+			Handler:    _RelationService_SuggestUsers_Handler,
+		},
+		{
+			MethodName: "GetCommonFollowList",
+			Handler:    _RelationService_GetCommonFollowList_Handler,
+		},
 		{
 			MethodName: "IsFollow",
 			Handler:    _RelationService_IsFollow_Handler,
 		},
+		{
+			MethodName: "IsBlocked",
+			Handler:    _RelationService_IsBlocked_Handler,
+		},
+		{
+			MethodName: "GetMuteList",
+			Handler:    _RelationService_GetMuteList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "relation/service/v1/relation.proto",
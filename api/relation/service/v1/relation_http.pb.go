@@ -20,20 +20,41 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationRelationServiceBlockAction = "/relation.service.v1.RelationService/BlockAction"
+const OperationRelationServiceFollowRequestAction = "/relation.service.v1.RelationService/FollowRequestAction"
+const OperationRelationServiceGetBlockList = "/relation.service.v1.RelationService/GetBlockList"
+const OperationRelationServiceGetCommonFollowList = "/relation.service.v1.RelationService/GetCommonFollowList"
 const OperationRelationServiceGetFollowRelationList = "/relation.service.v1.RelationService/GetFollowRelationList"
+const OperationRelationServiceGetFollowRequestList = "/relation.service.v1.RelationService/GetFollowRequestList"
 const OperationRelationServiceGetFollowerRelationList = "/relation.service.v1.RelationService/GetFollowerRelationList"
 const OperationRelationServiceGetFriendRelationList = "/relation.service.v1.RelationService/GetFriendRelationList"
+const OperationRelationServiceMuteAction = "/relation.service.v1.RelationService/MuteAction"
 const OperationRelationServiceRelationAction = "/relation.service.v1.RelationService/RelationAction"
+const OperationRelationServiceSuggestUsers = "/relation.service.v1.RelationService/SuggestUsers"
 
 type RelationServiceHTTPServer interface {
+	// BlockAction 屏蔽或取消屏蔽用户(客户端)
+	BlockAction(context.Context, *BlockActionRequest) (*BlockActionReply, error)
+	// FollowRequestAction 同意或拒绝关注请求(客户端)
+	FollowRequestAction(context.Context, *FollowRequestActionRequest) (*FollowRequestActionReply, error)
+	// GetBlockList 获取屏蔽列表(客户端)
+	GetBlockList(context.Context, *BlockListRequest) (*BlockListReply, error)
+	// GetCommonFollowList 获取自己关注的人中同样关注了对方的用户(客户端)
+	GetCommonFollowList(context.Context, *CommonFollowListRequest) (*CommonFollowListReply, error)
 	// GetFollowRelationList 获取关注列表(客户端)
 	GetFollowRelationList(context.Context, *RelationFollowListRequest) (*RelationFollowListReply, error)
+	// GetFollowRequestList 获取待处理的关注请求列表(客户端)
+	GetFollowRequestList(context.Context, *FollowRequestListRequest) (*FollowRequestListReply, error)
 	// GetFollowerRelationList 获取粉丝列表(客户端)
 	GetFollowerRelationList(context.Context, *RelationFollowerListRequest) (*RelationFollowerListReply, error)
 	// GetFriendRelationList 获取好友列表(客户端)
 	GetFriendRelationList(context.Context, *RelationFriendListRequest) (*RelationFriendListReply, error)
+	// MuteAction 静音或取消静音用户(客户端)
+	MuteAction(context.Context, *MuteActionRequest) (*MuteActionReply, error)
 	// RelationAction 关注或取关用户(客户端)
 	RelationAction(context.Context, *RelationActionRequest) (*RelationActionReply, error)
+	// SuggestUsers 获取可能认识的人(客户端)
+	SuggestUsers(context.Context, *SuggestUsersRequest) (*SuggestUsersReply, error)
 }
 
 func RegisterRelationServiceHTTPServer(s *http.Server, srv RelationServiceHTTPServer) {
@@ -42,6 +63,13 @@ func RegisterRelationServiceHTTPServer(s *http.Server, srv RelationServiceHTTPSe
 	r.GET("/douyin/relation/follow/list", _RelationService_GetFollowRelationList0_HTTP_Handler(srv))
 	r.POST("/douyin/relation/action", _RelationService_RelationAction0_HTTP_Handler(srv))
 	r.GET("/douyin/relation/friend/list", _RelationService_GetFriendRelationList0_HTTP_Handler(srv))
+	r.POST("/douyin/relation/block/action", _RelationService_BlockAction0_HTTP_Handler(srv))
+	r.POST("/douyin/relation/mute/action", _RelationService_MuteAction0_HTTP_Handler(srv))
+	r.GET("/douyin/relation/block/list", _RelationService_GetBlockList0_HTTP_Handler(srv))
+	r.POST("/douyin/relation/request/action", _RelationService_FollowRequestAction0_HTTP_Handler(srv))
+	r.GET("/douyin/relation/request/list", _RelationService_GetFollowRequestList0_HTTP_Handler(srv))
+	r.GET("/douyin/relation/suggest/list", _RelationService_SuggestUsers0_HTTP_Handler(srv))
+	r.GET("/douyin/relation/common/list", _RelationService_GetCommonFollowList0_HTTP_Handler(srv))
 }
 
 func _RelationService_GetFollowerRelationList0_HTTP_Handler(srv RelationServiceHTTPServer) func(ctx http.Context) error {
@@ -123,11 +151,160 @@ func _RelationService_GetFriendRelationList0_HTTP_Handler(srv RelationServiceHTT
 	}
 }
 
+func _RelationService_BlockAction0_HTTP_Handler(srv RelationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BlockActionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRelationServiceBlockAction)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BlockAction(ctx, req.(*BlockActionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BlockActionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RelationService_MuteAction0_HTTP_Handler(srv RelationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MuteActionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRelationServiceMuteAction)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MuteAction(ctx, req.(*MuteActionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MuteActionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RelationService_GetBlockList0_HTTP_Handler(srv RelationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BlockListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRelationServiceGetBlockList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetBlockList(ctx, req.(*BlockListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BlockListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RelationService_FollowRequestAction0_HTTP_Handler(srv RelationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FollowRequestActionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRelationServiceFollowRequestAction)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FollowRequestAction(ctx, req.(*FollowRequestActionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*FollowRequestActionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RelationService_GetFollowRequestList0_HTTP_Handler(srv RelationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FollowRequestListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRelationServiceGetFollowRequestList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetFollowRequestList(ctx, req.(*FollowRequestListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*FollowRequestListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RelationService_SuggestUsers0_HTTP_Handler(srv RelationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SuggestUsersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRelationServiceSuggestUsers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SuggestUsers(ctx, req.(*SuggestUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SuggestUsersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RelationService_GetCommonFollowList0_HTTP_Handler(srv RelationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CommonFollowListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRelationServiceGetCommonFollowList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCommonFollowList(ctx, req.(*CommonFollowListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CommonFollowListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type RelationServiceHTTPClient interface {
+	BlockAction(ctx context.Context, req *BlockActionRequest, opts ...http.CallOption) (rsp *BlockActionReply, err error)
+	FollowRequestAction(ctx context.Context, req *FollowRequestActionRequest, opts ...http.CallOption) (rsp *FollowRequestActionReply, err error)
+	GetBlockList(ctx context.Context, req *BlockListRequest, opts ...http.CallOption) (rsp *BlockListReply, err error)
+	GetCommonFollowList(ctx context.Context, req *CommonFollowListRequest, opts ...http.CallOption) (rsp *CommonFollowListReply, err error)
 	GetFollowRelationList(ctx context.Context, req *RelationFollowListRequest, opts ...http.CallOption) (rsp *RelationFollowListReply, err error)
+	GetFollowRequestList(ctx context.Context, req *FollowRequestListRequest, opts ...http.CallOption) (rsp *FollowRequestListReply, err error)
 	GetFollowerRelationList(ctx context.Context, req *RelationFollowerListRequest, opts ...http.CallOption) (rsp *RelationFollowerListReply, err error)
 	GetFriendRelationList(ctx context.Context, req *RelationFriendListRequest, opts ...http.CallOption) (rsp *RelationFriendListReply, err error)
+	MuteAction(ctx context.Context, req *MuteActionRequest, opts ...http.CallOption) (rsp *MuteActionReply, err error)
 	RelationAction(ctx context.Context, req *RelationActionRequest, opts ...http.CallOption) (rsp *RelationActionReply, err error)
+	SuggestUsers(ctx context.Context, req *SuggestUsersRequest, opts ...http.CallOption) (rsp *SuggestUsersReply, err error)
 }
 
 type RelationServiceHTTPClientImpl struct {
@@ -138,6 +315,58 @@ func NewRelationServiceHTTPClient(client *http.Client) RelationServiceHTTPClient
 	return &RelationServiceHTTPClientImpl{client}
 }
 
+func (c *RelationServiceHTTPClientImpl) BlockAction(ctx context.Context, in *BlockActionRequest, opts ...http.CallOption) (*BlockActionReply, error) {
+	var out BlockActionReply
+	pattern := "/douyin/relation/block/action"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRelationServiceBlockAction))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RelationServiceHTTPClientImpl) FollowRequestAction(ctx context.Context, in *FollowRequestActionRequest, opts ...http.CallOption) (*FollowRequestActionReply, error) {
+	var out FollowRequestActionReply
+	pattern := "/douyin/relation/request/action"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRelationServiceFollowRequestAction))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RelationServiceHTTPClientImpl) GetBlockList(ctx context.Context, in *BlockListRequest, opts ...http.CallOption) (*BlockListReply, error) {
+	var out BlockListReply
+	pattern := "/douyin/relation/block/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRelationServiceGetBlockList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RelationServiceHTTPClientImpl) GetCommonFollowList(ctx context.Context, in *CommonFollowListRequest, opts ...http.CallOption) (*CommonFollowListReply, error) {
+	var out CommonFollowListReply
+	pattern := "/douyin/relation/common/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRelationServiceGetCommonFollowList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *RelationServiceHTTPClientImpl) GetFollowRelationList(ctx context.Context, in *RelationFollowListRequest, opts ...http.CallOption) (*RelationFollowListReply, error) {
 	var out RelationFollowListReply
 	pattern := "/douyin/relation/follow/list"
@@ -151,6 +380,19 @@ func (c *RelationServiceHTTPClientImpl) GetFollowRelationList(ctx context.Contex
 	return &out, err
 }
 
+func (c *RelationServiceHTTPClientImpl) GetFollowRequestList(ctx context.Context, in *FollowRequestListRequest, opts ...http.CallOption) (*FollowRequestListReply, error) {
+	var out FollowRequestListReply
+	pattern := "/douyin/relation/request/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRelationServiceGetFollowRequestList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *RelationServiceHTTPClientImpl) GetFollowerRelationList(ctx context.Context, in *RelationFollowerListRequest, opts ...http.CallOption) (*RelationFollowerListReply, error) {
 	var out RelationFollowerListReply
 	pattern := "/douyin/relation/follower/list"
@@ -177,6 +419,19 @@ func (c *RelationServiceHTTPClientImpl) GetFriendRelationList(ctx context.Contex
 	return &out, err
 }
 
+func (c *RelationServiceHTTPClientImpl) MuteAction(ctx context.Context, in *MuteActionRequest, opts ...http.CallOption) (*MuteActionReply, error) {
+	var out MuteActionReply
+	pattern := "/douyin/relation/mute/action"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRelationServiceMuteAction))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *RelationServiceHTTPClientImpl) RelationAction(ctx context.Context, in *RelationActionRequest, opts ...http.CallOption) (*RelationActionReply, error) {
 	var out RelationActionReply
 	pattern := "/douyin/relation/action"
@@ -189,3 +444,16 @@ func (c *RelationServiceHTTPClientImpl) RelationAction(ctx context.Context, in *
 	}
 	return &out, err
 }
+
+func (c *RelationServiceHTTPClientImpl) SuggestUsers(ctx context.Context, in *SuggestUsersRequest, opts ...http.CallOption) (*SuggestUsersReply, error) {
+	var out SuggestUsersReply
+	pattern := "/douyin/relation/suggest/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRelationServiceSuggestUsers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
@@ -1082,3 +1082,1432 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = UserInfosReplyValidationError{}
+
+// Validate checks the field values on UserSettings with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserSettings) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserSettings with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserSettingsMultiError, or
+// nil if none found.
+func (m *UserSettings) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserSettings) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FavoriteVisibility
+
+	// no validation rules for IsPrivate
+
+	if len(errors) > 0 {
+		return UserSettingsMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserSettingsMultiError is an error wrapping multiple validation errors
+// returned by UserSettings.ValidateAll() if the designated constraints aren't met.
+type UserSettingsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserSettingsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserSettingsMultiError) AllErrors() []error { return m }
+
+// UserSettingsValidationError is the validation error returned by
+// UserSettings.Validate if the designated constraints aren't met.
+type UserSettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserSettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserSettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserSettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserSettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserSettingsValidationError) ErrorName() string { return "UserSettingsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserSettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserSettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserSettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserSettingsValidationError{}
+
+// Validate checks the field values on UpdateUserSettingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUserSettingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUserSettingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateUserSettingsRequestMultiError, or nil if none found.
+func (m *UpdateUserSettingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUserSettingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := UpdateUserSettingsRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFavoriteVisibility() > 2 {
+		err := UpdateUserSettingsRequestValidationError{
+			field:  "FavoriteVisibility",
+			reason: "value must be less than or equal to 2",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IsPrivate
+
+	if len(errors) > 0 {
+		return UpdateUserSettingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUserSettingsRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateUserSettingsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateUserSettingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUserSettingsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUserSettingsRequestMultiError) AllErrors() []error { return m }
+
+// UpdateUserSettingsRequestValidationError is the validation error returned by
+// UpdateUserSettingsRequest.Validate if the designated constraints aren't met.
+type UpdateUserSettingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserSettingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserSettingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserSettingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserSettingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserSettingsRequestValidationError) ErrorName() string {
+	return "UpdateUserSettingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUserSettingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserSettingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserSettingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserSettingsRequestValidationError{}
+
+// Validate checks the field values on UpdateUserSettingsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUserSettingsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUserSettingsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateUserSettingsReplyMultiError, or nil if none found.
+func (m *UpdateUserSettingsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUserSettingsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	if len(errors) > 0 {
+		return UpdateUserSettingsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUserSettingsReplyMultiError is an error wrapping multiple validation
+// errors returned by UpdateUserSettingsReply.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateUserSettingsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUserSettingsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUserSettingsReplyMultiError) AllErrors() []error { return m }
+
+// UpdateUserSettingsReplyValidationError is the validation error returned by
+// UpdateUserSettingsReply.Validate if the designated constraints aren't met.
+type UpdateUserSettingsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserSettingsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserSettingsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserSettingsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserSettingsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserSettingsReplyValidationError) ErrorName() string {
+	return "UpdateUserSettingsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUserSettingsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserSettingsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserSettingsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserSettingsReplyValidationError{}
+
+// Validate checks the field values on UserSettingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserSettingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserSettingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserSettingsRequestMultiError, or nil if none found.
+func (m *UserSettingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserSettingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return UserSettingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserSettingsRequestMultiError is an error wrapping multiple validation
+// errors returned by UserSettingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UserSettingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserSettingsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserSettingsRequestMultiError) AllErrors() []error { return m }
+
+// UserSettingsRequestValidationError is the validation error returned by
+// UserSettingsRequest.Validate if the designated constraints aren't met.
+type UserSettingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserSettingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserSettingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserSettingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserSettingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserSettingsRequestValidationError) ErrorName() string {
+	return "UserSettingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserSettingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserSettingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserSettingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserSettingsRequestValidationError{}
+
+// Validate checks the field values on UserSettingsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserSettingsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserSettingsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserSettingsReplyMultiError, or nil if none found.
+func (m *UserSettingsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserSettingsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSettings()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserSettingsReplyValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserSettingsReplyValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSettings()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserSettingsReplyValidationError{
+				field:  "Settings",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserSettingsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserSettingsReplyMultiError is an error wrapping multiple validation errors
+// returned by UserSettingsReply.ValidateAll() if the designated constraints
+// aren't met.
+type UserSettingsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserSettingsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserSettingsReplyMultiError) AllErrors() []error { return m }
+
+// UserSettingsReplyValidationError is the validation error returned by
+// UserSettingsReply.Validate if the designated constraints aren't met.
+type UserSettingsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserSettingsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserSettingsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserSettingsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserSettingsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserSettingsReplyValidationError) ErrorName() string {
+	return "UserSettingsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserSettingsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserSettingsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserSettingsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserSettingsReplyValidationError{}
+
+// Validate checks the field values on PublicKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PublicKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublicKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PublicKeyMultiError, or nil
+// if none found.
+func (m *PublicKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublicKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for PublicKey
+
+	// no validation rules for IsCurrent
+
+	// no validation rules for CreateTime
+
+	if len(errors) > 0 {
+		return PublicKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PublicKeyMultiError is an error wrapping multiple validation errors returned
+// by PublicKey.ValidateAll() if the designated constraints aren't met.
+type PublicKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublicKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublicKeyMultiError) AllErrors() []error { return m }
+
+// PublicKeyValidationError is the validation error returned by
+// PublicKey.Validate if the designated constraints aren't met.
+type PublicKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublicKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublicKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublicKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublicKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublicKeyValidationError) ErrorName() string { return "PublicKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PublicKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublicKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublicKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublicKeyValidationError{}
+
+// Validate checks the field values on RegisterPublicKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegisterPublicKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterPublicKeyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterPublicKeyRequestMultiError, or nil if none found.
+func (m *RegisterPublicKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegisterPublicKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := RegisterPublicKeyRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetPublicKey()); l < 1 || l > 2048 {
+		err := RegisterPublicKeyRequestValidationError{
+			field:  "PublicKey",
+			reason: "value length must be between 1 and 2048 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RegisterPublicKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegisterPublicKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by RegisterPublicKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RegisterPublicKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegisterPublicKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegisterPublicKeyRequestMultiError) AllErrors() []error { return m }
+
+// RegisterPublicKeyRequestValidationError is the validation error returned by
+// RegisterPublicKeyRequest.Validate if the designated constraints aren't met.
+type RegisterPublicKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterPublicKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterPublicKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterPublicKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterPublicKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterPublicKeyRequestValidationError) ErrorName() string {
+	return "RegisterPublicKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegisterPublicKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegisterPublicKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegisterPublicKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegisterPublicKeyRequestValidationError{}
+
+// Validate checks the field values on RegisterPublicKeyReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegisterPublicKeyReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterPublicKeyReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterPublicKeyReplyMultiError, or nil if none found.
+func (m *RegisterPublicKeyReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegisterPublicKeyReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RegisterPublicKeyReplyValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RegisterPublicKeyReplyValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RegisterPublicKeyReplyValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RegisterPublicKeyReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegisterPublicKeyReplyMultiError is an error wrapping multiple validation
+// errors returned by RegisterPublicKeyReply.ValidateAll() if the designated
+// constraints aren't met.
+type RegisterPublicKeyReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegisterPublicKeyReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegisterPublicKeyReplyMultiError) AllErrors() []error { return m }
+
+// RegisterPublicKeyReplyValidationError is the validation error returned by
+// RegisterPublicKeyReply.Validate if the designated constraints aren't met.
+type RegisterPublicKeyReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterPublicKeyReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterPublicKeyReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterPublicKeyReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterPublicKeyReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterPublicKeyReplyValidationError) ErrorName() string {
+	return "RegisterPublicKeyReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegisterPublicKeyReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegisterPublicKeyReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegisterPublicKeyReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegisterPublicKeyReplyValidationError{}
+
+// Validate checks the field values on PublicKeyRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PublicKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublicKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PublicKeyRequestMultiError, or nil if none found.
+func (m *PublicKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublicKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := PublicKeyRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() <= 0 {
+		err := PublicKeyRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PublicKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PublicKeyRequestMultiError is an error wrapping multiple validation errors
+// returned by PublicKeyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PublicKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublicKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublicKeyRequestMultiError) AllErrors() []error { return m }
+
+// PublicKeyRequestValidationError is the validation error returned by
+// PublicKeyRequest.Validate if the designated constraints aren't met.
+type PublicKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublicKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublicKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublicKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublicKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublicKeyRequestValidationError) ErrorName() string { return "PublicKeyRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PublicKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublicKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublicKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublicKeyRequestValidationError{}
+
+// Validate checks the field values on PublicKeyReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PublicKeyReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublicKeyReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PublicKeyReplyMultiError,
+// or nil if none found.
+func (m *PublicKeyReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublicKeyReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for StatusMsg
+
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PublicKeyReplyValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PublicKeyReplyValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PublicKeyReplyValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PublicKeyReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PublicKeyReplyMultiError is an error wrapping multiple validation errors
+// returned by PublicKeyReply.ValidateAll() if the designated constraints
+// aren't met.
+type PublicKeyReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublicKeyReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublicKeyReplyMultiError) AllErrors() []error { return m }
+
+// PublicKeyReplyValidationError is the validation error returned by
+// PublicKeyReply.Validate if the designated constraints aren't met.
+type PublicKeyReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublicKeyReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublicKeyReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublicKeyReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublicKeyReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublicKeyReplyValidationError) ErrorName() string { return "PublicKeyReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PublicKeyReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublicKeyReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublicKeyReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublicKeyReplyValidationError{}
+
+// Validate checks the field values on PublicKeysRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PublicKeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublicKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PublicKeysRequestMultiError, or nil if none found.
+func (m *PublicKeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublicKeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PublicKeysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PublicKeysRequestMultiError is an error wrapping multiple validation errors
+// returned by PublicKeysRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PublicKeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublicKeysRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublicKeysRequestMultiError) AllErrors() []error { return m }
+
+// PublicKeysRequestValidationError is the validation error returned by
+// PublicKeysRequest.Validate if the designated constraints aren't met.
+type PublicKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublicKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublicKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublicKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublicKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublicKeysRequestValidationError) ErrorName() string {
+	return "PublicKeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PublicKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublicKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublicKeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublicKeysRequestValidationError{}
+
+// Validate checks the field values on PublicKeysReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PublicKeysReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublicKeysReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PublicKeysReplyMultiError, or nil if none found.
+func (m *PublicKeysReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublicKeysReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PublicKeysReplyValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PublicKeysReplyValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PublicKeysReplyValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PublicKeysReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PublicKeysReplyMultiError is an error wrapping multiple validation errors
+// returned by PublicKeysReply.ValidateAll() if the designated constraints
+// aren't met.
+type PublicKeysReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublicKeysReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublicKeysReplyMultiError) AllErrors() []error { return m }
+
+// PublicKeysReplyValidationError is the validation error returned by
+// PublicKeysReply.Validate if the designated constraints aren't met.
+type PublicKeysReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublicKeysReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublicKeysReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublicKeysReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublicKeysReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublicKeysReplyValidationError) ErrorName() string { return "PublicKeysReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PublicKeysReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublicKeysReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublicKeysReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublicKeysReplyValidationError{}
@@ -20,10 +20,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	UserService_UserRegister_FullMethodName = "/user.service.v1.UserService/UserRegister"
-	UserService_UserLogin_FullMethodName    = "/user.service.v1.UserService/UserLogin"
-	UserService_GetUserInfo_FullMethodName  = "/user.service.v1.UserService/GetUserInfo"
-	UserService_GetUserInfos_FullMethodName = "/user.service.v1.UserService/GetUserInfos"
+	UserService_UserRegister_FullMethodName       = "/user.service.v1.UserService/UserRegister"
+	UserService_UserLogin_FullMethodName          = "/user.service.v1.UserService/UserLogin"
+	UserService_GetUserInfo_FullMethodName        = "/user.service.v1.UserService/GetUserInfo"
+	UserService_GetUserInfos_FullMethodName       = "/user.service.v1.UserService/GetUserInfos"
+	UserService_UpdateUserSettings_FullMethodName = "/user.service.v1.UserService/UpdateUserSettings"
+	UserService_GetUserSettings_FullMethodName    = "/user.service.v1.UserService/GetUserSettings"
+	UserService_RegisterPublicKey_FullMethodName  = "/user.service.v1.UserService/RegisterPublicKey"
+	UserService_GetPublicKey_FullMethodName       = "/user.service.v1.UserService/GetPublicKey"
+	UserService_GetPublicKeys_FullMethodName      = "/user.service.v1.UserService/GetPublicKeys"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -38,6 +43,16 @@ type UserServiceClient interface {
 	GetUserInfo(ctx context.Context, in *UserInfoRequest, opts ...grpc.CallOption) (*UserInfoReply, error)
 	// 其他服务请求批量获取用户信息
 	GetUserInfos(ctx context.Context, in *UserInfosRequest, opts ...grpc.CallOption) (*UserInfosReply, error)
+	// 用户修改自己的隐私设置
+	UpdateUserSettings(ctx context.Context, in *UpdateUserSettingsRequest, opts ...grpc.CallOption) (*UpdateUserSettingsReply, error)
+	// 其他服务请求获取用户的隐私设置
+	GetUserSettings(ctx context.Context, in *UserSettingsRequest, opts ...grpc.CallOption) (*UserSettingsReply, error)
+	// 用户注册端到端加密私信的公钥，新公钥成为当前公钥，旧公钥保留用于解密旧消息
+	RegisterPublicKey(ctx context.Context, in *RegisterPublicKeyRequest, opts ...grpc.CallOption) (*RegisterPublicKeyReply, error)
+	// 获取用户的当前公钥
+	GetPublicKey(ctx context.Context, in *PublicKeyRequest, opts ...grpc.CallOption) (*PublicKeyReply, error)
+	// message服务请求根据公钥id获取公钥
+	GetPublicKeys(ctx context.Context, in *PublicKeysRequest, opts ...grpc.CallOption) (*PublicKeysReply, error)
 }
 
 type userServiceClient struct {
@@ -84,6 +99,51 @@ func (c *userServiceClient) GetUserInfos(ctx context.Context, in *UserInfosReque
 	return out, nil
 }
 
+func (c *userServiceClient) UpdateUserSettings(ctx context.Context, in *UpdateUserSettingsRequest, opts ...grpc.CallOption) (*UpdateUserSettingsReply, error) {
+	out := new(UpdateUserSettingsReply)
+	err := c.cc.Invoke(ctx, UserService_UpdateUserSettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserSettings(ctx context.Context, in *UserSettingsRequest, opts ...grpc.CallOption) (*UserSettingsReply, error) {
+	out := new(UserSettingsReply)
+	err := c.cc.Invoke(ctx, UserService_GetUserSettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RegisterPublicKey(ctx context.Context, in *RegisterPublicKeyRequest, opts ...grpc.CallOption) (*RegisterPublicKeyReply, error) {
+	out := new(RegisterPublicKeyReply)
+	err := c.cc.Invoke(ctx, UserService_RegisterPublicKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPublicKey(ctx context.Context, in *PublicKeyRequest, opts ...grpc.CallOption) (*PublicKeyReply, error) {
+	out := new(PublicKeyReply)
+	err := c.cc.Invoke(ctx, UserService_GetPublicKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPublicKeys(ctx context.Context, in *PublicKeysRequest, opts ...grpc.CallOption) (*PublicKeysReply, error) {
+	out := new(PublicKeysReply)
+	err := c.cc.Invoke(ctx, UserService_GetPublicKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -96,6 +156,16 @@ type UserServiceServer interface {
 	GetUserInfo(context.Context, *UserInfoRequest) (*UserInfoReply, error)
 	// 其他服务请求批量获取用户信息
 	GetUserInfos(context.Context, *UserInfosRequest) (*UserInfosReply, error)
+	// 用户修改自己的隐私设置
+	UpdateUserSettings(context.Context, *UpdateUserSettingsRequest) (*UpdateUserSettingsReply, error)
+	// 其他服务请求获取用户的隐私设置
+	GetUserSettings(context.Context, *UserSettingsRequest) (*UserSettingsReply, error)
+	// 用户注册端到端加密私信的公钥，新公钥成为当前公钥，旧公钥保留用于解密旧消息
+	RegisterPublicKey(context.Context, *RegisterPublicKeyRequest) (*RegisterPublicKeyReply, error)
+	// 获取用户的当前公钥
+	GetPublicKey(context.Context, *PublicKeyRequest) (*PublicKeyReply, error)
+	// message服务请求根据公钥id获取公钥
+	GetPublicKeys(context.Context, *PublicKeysRequest) (*PublicKeysReply, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -115,6 +185,21 @@ func (UnimplementedUserServiceServer) GetUserInfo(context.Context, *UserInfoRequ
 func (UnimplementedUserServiceServer) GetUserInfos(context.Context, *UserInfosRequest) (*UserInfosReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserInfos not implemented")
 }
+func (UnimplementedUserServiceServer) UpdateUserSettings(context.Context, *UpdateUserSettingsRequest) (*UpdateUserSettingsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserSettings not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserSettings(context.Context, *UserSettingsRequest) (*UserSettingsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserSettings not implemented")
+}
+func (UnimplementedUserServiceServer) RegisterPublicKey(context.Context, *RegisterPublicKeyRequest) (*RegisterPublicKeyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPublicKey not implemented")
+}
+func (UnimplementedUserServiceServer) GetPublicKey(context.Context, *PublicKeyRequest) (*PublicKeyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicKey not implemented")
+}
+func (UnimplementedUserServiceServer) GetPublicKeys(context.Context, *PublicKeysRequest) (*PublicKeysReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicKeys not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -200,6 +285,96 @@ func _UserService_GetUserInfos_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UpdateUserSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUserSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUserSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUserSettings(ctx, req.(*UpdateUserSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserSettings(ctx, req.(*UserSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RegisterPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RegisterPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RegisterPublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RegisterPublicKey(ctx, req.(*RegisterPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPublicKey(ctx, req.(*PublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPublicKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublicKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPublicKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPublicKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPublicKeys(ctx, req.(*PublicKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -223,6 +398,26 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserInfos",
 			Handler:    _UserService_GetUserInfos_Handler,
 		},
+		{
+			MethodName: "UpdateUserSettings",
+			Handler:    _UserService_UpdateUserSettings_Handler,
+		},
+		{
+			MethodName: "GetUserSettings",
+			Handler:    _UserService_GetUserSettings_Handler,
+		},
+		{
+			MethodName: "RegisterPublicKey",
+			Handler:    _UserService_RegisterPublicKey_Handler,
+		},
+		{
+			MethodName: "GetPublicKey",
+			Handler:    _UserService_GetPublicKey_Handler,
+		},
+		{
+			MethodName: "GetPublicKeys",
+			Handler:    _UserService_GetPublicKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user/service/v1/user.proto",
@@ -20,13 +20,22 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationUserServiceGetPublicKey = "/user.service.v1.UserService/GetPublicKey"
 const OperationUserServiceGetUserInfo = "/user.service.v1.UserService/GetUserInfo"
+const OperationUserServiceRegisterPublicKey = "/user.service.v1.UserService/RegisterPublicKey"
+const OperationUserServiceUpdateUserSettings = "/user.service.v1.UserService/UpdateUserSettings"
 const OperationUserServiceUserLogin = "/user.service.v1.UserService/UserLogin"
 const OperationUserServiceUserRegister = "/user.service.v1.UserService/UserRegister"
 
 type UserServiceHTTPServer interface {
+	// GetPublicKey 获取用户的当前公钥
+	GetPublicKey(context.Context, *PublicKeyRequest) (*PublicKeyReply, error)
 	// GetUserInfo 用户获取自己的信息
 	GetUserInfo(context.Context, *UserInfoRequest) (*UserInfoReply, error)
+	// RegisterPublicKey 用户注册端到端加密私信的公钥，新公钥成为当前公钥，旧公钥保留用于解密旧消息
+	RegisterPublicKey(context.Context, *RegisterPublicKeyRequest) (*RegisterPublicKeyReply, error)
+	// UpdateUserSettings 用户修改自己的隐私设置
+	UpdateUserSettings(context.Context, *UpdateUserSettingsRequest) (*UpdateUserSettingsReply, error)
 	// UserLogin 用户登陆
 	UserLogin(context.Context, *UserLoginRequest) (*UserLoginReply, error)
 	// UserRegister 用户注册
@@ -38,6 +47,9 @@ func RegisterUserServiceHTTPServer(s *http.Server, srv UserServiceHTTPServer) {
 	r.POST("/douyin/user/register", _UserService_UserRegister0_HTTP_Handler(srv))
 	r.POST("/douyin/user/login", _UserService_UserLogin0_HTTP_Handler(srv))
 	r.GET("/douyin/user", _UserService_GetUserInfo0_HTTP_Handler(srv))
+	r.POST("/douyin/user/settings", _UserService_UpdateUserSettings0_HTTP_Handler(srv))
+	r.POST("/douyin/user/key/register", _UserService_RegisterPublicKey0_HTTP_Handler(srv))
+	r.GET("/douyin/user/key", _UserService_GetPublicKey0_HTTP_Handler(srv))
 }
 
 func _UserService_UserRegister0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
@@ -103,8 +115,74 @@ func _UserService_GetUserInfo0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx
 	}
 }
 
+func _UserService_UpdateUserSettings0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateUserSettingsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceUpdateUserSettings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateUserSettings(ctx, req.(*UpdateUserSettingsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateUserSettingsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_RegisterPublicKey0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RegisterPublicKeyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceRegisterPublicKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RegisterPublicKey(ctx, req.(*RegisterPublicKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RegisterPublicKeyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_GetPublicKey0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PublicKeyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceGetPublicKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPublicKey(ctx, req.(*PublicKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PublicKeyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type UserServiceHTTPClient interface {
+	GetPublicKey(ctx context.Context, req *PublicKeyRequest, opts ...http.CallOption) (rsp *PublicKeyReply, err error)
 	GetUserInfo(ctx context.Context, req *UserInfoRequest, opts ...http.CallOption) (rsp *UserInfoReply, err error)
+	RegisterPublicKey(ctx context.Context, req *RegisterPublicKeyRequest, opts ...http.CallOption) (rsp *RegisterPublicKeyReply, err error)
+	UpdateUserSettings(ctx context.Context, req *UpdateUserSettingsRequest, opts ...http.CallOption) (rsp *UpdateUserSettingsReply, err error)
 	UserLogin(ctx context.Context, req *UserLoginRequest, opts ...http.CallOption) (rsp *UserLoginReply, err error)
 	UserRegister(ctx context.Context, req *UserRegisterRequest, opts ...http.CallOption) (rsp *UserRegisterReply, err error)
 }
@@ -117,6 +195,19 @@ func NewUserServiceHTTPClient(client *http.Client) UserServiceHTTPClient {
 	return &UserServiceHTTPClientImpl{client}
 }
 
+func (c *UserServiceHTTPClientImpl) GetPublicKey(ctx context.Context, in *PublicKeyRequest, opts ...http.CallOption) (*PublicKeyReply, error) {
+	var out PublicKeyReply
+	pattern := "/douyin/user/key"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceGetPublicKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserServiceHTTPClientImpl) GetUserInfo(ctx context.Context, in *UserInfoRequest, opts ...http.CallOption) (*UserInfoReply, error) {
 	var out UserInfoReply
 	pattern := "/douyin/user"
@@ -130,6 +221,32 @@ func (c *UserServiceHTTPClientImpl) GetUserInfo(ctx context.Context, in *UserInf
 	return &out, err
 }
 
+func (c *UserServiceHTTPClientImpl) RegisterPublicKey(ctx context.Context, in *RegisterPublicKeyRequest, opts ...http.CallOption) (*RegisterPublicKeyReply, error) {
+	var out RegisterPublicKeyReply
+	pattern := "/douyin/user/key/register"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceRegisterPublicKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserServiceHTTPClientImpl) UpdateUserSettings(ctx context.Context, in *UpdateUserSettingsRequest, opts ...http.CallOption) (*UpdateUserSettingsReply, error) {
+	var out UpdateUserSettingsReply
+	pattern := "/douyin/user/settings"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceUpdateUserSettings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserServiceHTTPClientImpl) UserLogin(ctx context.Context, in *UserLoginRequest, opts ...http.CallOption) (*UserLoginReply, error) {
 	var out UserLoginReply
 	pattern := "/douyin/user/login"
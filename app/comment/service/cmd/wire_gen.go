@@ -22,18 +22,23 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, jwt *conf.JWT, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, jwt *conf.JWT, comment *conf.Comment, minio *conf.Minio, logger log.Logger) (*kratos.App, func(), error) {
 	db := data.NewMysqlConn(confData, logger)
 	client := data.NewRedisConn(confData, logger)
 	writer := data.NewKafkaWriter(confData, logger)
-	dataData, cleanup, err := data.NewData(db, client, writer, logger)
+	extraConn := data.NewMinioExtraConn(minio, logger)
+	intraConn := data.NewMinioIntraConn(minio, logger)
+	minioXClient := data.NewMinioConn(minio, extraConn, intraConn, logger)
+	dataData, cleanup, err := data.NewData(db, client, writer, minioXClient, minio, logger)
 	if err != nil {
 		return nil, nil, err
 	}
 	discovery := server.NewDiscovery(registry)
 	userServiceClient := server.NewUserClient(discovery, logger)
-	commentRepo := data.NewCommentRepo(dataData, userServiceClient, logger)
-	commentUseCase := biz.NewCommentUseCase(commentRepo, logger)
+	publishServiceClient := server.NewPublishClient(discovery, logger)
+	relationServiceClient := server.NewRelationClient(discovery, logger)
+	commentRepo := data.NewCommentRepo(dataData, userServiceClient, publishServiceClient, relationServiceClient, logger)
+	commentUseCase := biz.NewCommentUseCase(comment, commentRepo, logger)
 	commentService := service.NewCommentService(commentUseCase, logger)
 	grpcServer := server.NewGRPCServer(confServer, commentService, logger)
 	httpServer := server.NewHTTPServer(confServer, jwt, commentService, logger)
@@ -12,10 +12,26 @@ var ProviderSet = wire.NewSet(NewCommentUseCase)
 const (
 	CreateType uint32 = 1
 	DeleteType uint32 = 2
+	EditType   uint32 = 3
 )
 
+const (
+	ReactType   uint32 = 1
+	UnReactType uint32 = 2
+)
+
+// Emojis 允许用于评论回应的表情
+var Emojis = map[string]struct{}{
+	"👍": {}, "👎": {}, "❤️": {}, "😂": {}, "😮": {},
+	"😢": {}, "😡": {}, "🎉": {}, "🔥": {}, "👏": {},
+}
+
 var (
 	ErrCommentTextEmpty  = errors.New("comment text is empty")
 	ErrInValidActionType = errors.New("invalid action type")
 	ErrInvalidId         = errors.New("invalid id")
+	ErrEditWindowExpired = errors.New("comment edit window expired")
+	ErrEmojiEmpty        = errors.New("emoji is empty")
+	ErrInvalidEmoji      = errors.New("emoji is not supported")
+	ErrInvalidTimeRange  = errors.New("invalid time range")
 )
@@ -2,15 +2,28 @@ package biz
 
 import (
 	"context"
+	"time"
+
+	"github.com/toomanysource/atreus/app/comment/service/internal/conf"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 type Comment struct {
-	Id         uint32
-	User       *User
-	Content    string
-	CreateDate string
+	Id           uint32
+	User         *User
+	Content      string
+	CreateTime   time.Time
+	IsEdited     bool
+	ImageUrl     string
+	ThumbnailUrl string
+	Reactions    []*Reaction
+}
+
+type Reaction struct {
+	Emoji     string
+	Count     uint32
+	IsReacted bool
 }
 
 type User struct {
@@ -28,26 +41,35 @@ type User struct {
 }
 
 type CommentRepo interface {
-	CreateComment(context.Context, uint32, string) (*Comment, error)
+	CreateComment(context.Context, uint32, string, []byte) (*Comment, error)
 	DeleteComment(context.Context, uint32, uint32) (*Comment, error)
-	GetComments(context.Context, uint32) ([]*Comment, error)
+	EditComment(context.Context, uint32, uint32, string, time.Time) (*Comment, error)
+	GetComments(context.Context, uint32, time.Time, time.Time) ([]*Comment, error)
+	AddReaction(context.Context, uint32, string) error
+	RemoveReaction(context.Context, uint32, string) error
 }
 
 type CommentUseCase struct {
-	repo CommentRepo
-	log  *log.Helper
+	repo       CommentRepo
+	editWindow time.Duration
+	log        *log.Helper
 }
 
-func NewCommentUseCase(cr CommentRepo, logger log.Logger) *CommentUseCase {
+func NewCommentUseCase(c *conf.Comment, cr CommentRepo, logger log.Logger) *CommentUseCase {
 	return &CommentUseCase{
-		repo: cr, log: log.NewHelper(log.With(logger, "model", "usecase/comment")),
+		repo:       cr,
+		editWindow: c.EditWindow.AsDuration(),
+		log:        log.NewHelper(log.With(logger, "model", "usecase/comment")),
 	}
 }
 
 func (uc *CommentUseCase) GetCommentList(
-	ctx context.Context, videoId uint32,
+	ctx context.Context, videoId uint32, start, end time.Time,
 ) ([]*Comment, error) {
-	comment, err := uc.repo.GetComments(ctx, videoId)
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		return nil, ErrInvalidTimeRange
+	}
+	comment, err := uc.repo.GetComments(ctx, videoId, start, end)
 	if err != nil {
 		uc.log.Errorf("GetComments err: %v", err)
 	}
@@ -56,14 +78,15 @@ func (uc *CommentUseCase) GetCommentList(
 
 func (uc *CommentUseCase) CommentAction(
 	ctx context.Context, videoId, commentId uint32,
-	actionType uint32, commentText string,
+	actionType uint32, commentText string, imageData []byte,
 ) (*Comment, error) {
 	switch actionType {
 	case CreateType:
-		if commentText == "" {
+		// 图片评论可以不填写文字内容
+		if commentText == "" && len(imageData) == 0 {
 			return nil, ErrCommentTextEmpty
 		}
-		comment, err := uc.repo.CreateComment(ctx, videoId, commentText)
+		comment, err := uc.repo.CreateComment(ctx, videoId, commentText, imageData)
 		if err != nil {
 			uc.log.Errorf("CreateComment err: %v", err)
 		}
@@ -77,7 +100,51 @@ func (uc *CommentUseCase) CommentAction(
 			uc.log.Errorf("DeleteComment err: %v", err)
 		}
 		return comment, err
+	case EditType:
+		if commentId == 0 {
+			return nil, ErrInvalidId
+		}
+		if commentText == "" {
+			return nil, ErrCommentTextEmpty
+		}
+		// 只允许编辑在编辑窗口内发布的评论
+		comment, err := uc.repo.EditComment(
+			ctx, videoId, commentId, commentText, time.Now().Add(-uc.editWindow))
+		if err != nil {
+			uc.log.Errorf("EditComment err: %v", err)
+		}
+		return comment, err
 	default:
 		return nil, ErrInValidActionType
 	}
 }
+
+func (uc *CommentUseCase) ReactionAction(
+	ctx context.Context, commentId, actionType uint32, emoji string,
+) error {
+	if commentId == 0 {
+		return ErrInvalidId
+	}
+	if emoji == "" {
+		return ErrEmojiEmpty
+	}
+	if _, ok := Emojis[emoji]; !ok {
+		return ErrInvalidEmoji
+	}
+	switch actionType {
+	case ReactType:
+		err := uc.repo.AddReaction(ctx, commentId, emoji)
+		if err != nil {
+			uc.log.Errorf("AddReaction err: %v", err)
+		}
+		return err
+	case UnReactType:
+		err := uc.repo.RemoveReaction(ctx, commentId, emoji)
+		if err != nil {
+			uc.log.Errorf("RemoveReaction err: %v", err)
+		}
+		return err
+	default:
+		return ErrInValidActionType
+	}
+}
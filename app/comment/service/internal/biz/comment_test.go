@@ -4,6 +4,11 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/toomanysource/atreus/app/comment/service/internal/conf"
 
 	"github.com/toomanysource/atreus/middleware"
 
@@ -21,7 +26,7 @@ var (
 				Name: "hahah",
 			},
 			Content:    "bushuwu1",
-			CreateDate: "08-01",
+			CreateTime: time.Date(2023, 8, 1, 0, 0, 0, 0, time.Local),
 		},
 		2: {
 			Id: 2,
@@ -30,7 +35,7 @@ var (
 				Name: "hahah",
 			},
 			Content:    "dadawd",
-			CreateDate: "08-02",
+			CreateTime: time.Date(2023, 8, 2, 0, 0, 0, 0, time.Local),
 		},
 		3: {
 			Id: 3,
@@ -39,7 +44,7 @@ var (
 				Name: "sefafa",
 			},
 			Content:    "bdzxvzad",
-			CreateDate: "08-03",
+			CreateTime: time.Date(2023, 8, 3, 0, 0, 0, 0, time.Local),
 		},
 		4: {
 			Id: 4,
@@ -48,7 +53,7 @@ var (
 				Name: "hahah",
 			},
 			Content:    "bvrbr",
-			CreateDate: "08-03",
+			CreateTime: time.Date(2023, 8, 3, 0, 0, 0, 0, time.Local),
 		},
 		5: {
 			Id: 5,
@@ -57,7 +62,7 @@ var (
 				Name: "brbs",
 			},
 			Content:    "bdadawfvrd",
-			CreateDate: "08-04",
+			CreateTime: time.Date(2023, 8, 4, 0, 0, 0, 0, time.Local),
 		},
 		6: {
 			Id: 6,
@@ -66,7 +71,7 @@ var (
 				Name: "bgssev",
 			},
 			Content:    "bdafagaagaga",
-			CreateDate: "08-05",
+			CreateTime: time.Date(2023, 8, 5, 0, 0, 0, 0, time.Local),
 		},
 	}
 )
@@ -74,7 +79,9 @@ var autoCount uint32 = 7
 
 type MockCommentRepo struct{}
 
-func (m *MockCommentRepo) CreateComment(ctx context.Context, videoId uint32, commentText string) (*Comment, error) {
+func (m *MockCommentRepo) CreateComment(
+	ctx context.Context, videoId uint32, commentText string, imageData []byte,
+) (*Comment, error) {
 	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
 	comment := &Comment{
 		Id: autoCount,
@@ -83,7 +90,7 @@ func (m *MockCommentRepo) CreateComment(ctx context.Context, videoId uint32, com
 			Name: "hahah",
 		},
 		Content:    commentText,
-		CreateDate: "08-01",
+		CreateTime: time.Now(),
 	}
 	testCommentsData[comment.Id] = comment
 	autoCount++
@@ -95,14 +102,66 @@ func (m *MockCommentRepo) DeleteComment(ctx context.Context, videoId, commentId
 	return nil, nil
 }
 
-func (m *MockCommentRepo) GetComments(ctx context.Context, videoId uint32) ([]*Comment, error) {
+func (m *MockCommentRepo) EditComment(
+	ctx context.Context, videoId, commentId uint32, commentText string, since time.Time,
+) (*Comment, error) {
+	comment, ok := testCommentsData[commentId]
+	if !ok {
+		return nil, ErrInvalidId
+	}
+	comment.Content = commentText
+	comment.IsEdited = true
+	return comment, nil
+}
+
+func (m *MockCommentRepo) GetComments(
+	ctx context.Context, videoId uint32, start, end time.Time,
+) ([]*Comment, error) {
 	var comments []*Comment
 	for _, comment := range testCommentsData {
+		if !start.IsZero() && comment.CreateTime.Before(start) {
+			continue
+		}
+		if !end.IsZero() && comment.CreateTime.After(end) {
+			continue
+		}
 		comments = append(comments, comment)
 	}
 	return comments, nil
 }
 
+func (m *MockCommentRepo) AddReaction(ctx context.Context, commentId uint32, emoji string) error {
+	comment, ok := testCommentsData[commentId]
+	if !ok {
+		return ErrInvalidId
+	}
+	for _, reaction := range comment.Reactions {
+		if reaction.Emoji == emoji {
+			if !reaction.IsReacted {
+				reaction.Count++
+				reaction.IsReacted = true
+			}
+			return nil
+		}
+	}
+	comment.Reactions = append(comment.Reactions, &Reaction{Emoji: emoji, Count: 1, IsReacted: true})
+	return nil
+}
+
+func (m *MockCommentRepo) RemoveReaction(ctx context.Context, commentId uint32, emoji string) error {
+	comment, ok := testCommentsData[commentId]
+	if !ok {
+		return ErrInvalidId
+	}
+	for _, reaction := range comment.Reactions {
+		if reaction.Emoji == emoji && reaction.IsReacted {
+			reaction.Count--
+			reaction.IsReacted = false
+		}
+	}
+	return nil
+}
+
 func (m *MockCommentRepo) GetCommentNumber(ctx context.Context, videoId uint32) (int64, error) {
 	return int64(len(testCommentsData)), nil
 }
@@ -113,22 +172,74 @@ var useCase *CommentUseCase
 
 func TestMain(m *testing.M) {
 	ctx = context.WithValue(ctx, middleware.UserIdKey("user_id"), uint32(1))
-	useCase = NewCommentUseCase(mockRepo, log.DefaultLogger)
+	useCase = NewCommentUseCase(
+		&conf.Comment{EditWindow: durationpb.New(5 * time.Minute)}, mockRepo, log.DefaultLogger)
 	r := m.Run()
 	os.Exit(r)
 }
 
 func TestCommentUsecase_CommentAction(t *testing.T) {
 	_, err := useCase.CommentAction(
-		ctx, 1, 0, 1, "test")
+		ctx, 1, 0, 1, "test", nil)
 	assert.Nil(t, err)
 	_, err = useCase.CommentAction(
-		ctx, 1, 1, 2, "")
+		ctx, 1, 0, 1, "", []byte("image"))
 	assert.Nil(t, err)
+	_, err = useCase.CommentAction(
+		ctx, 1, 0, 1, "", nil)
+	assert.Equal(t, ErrCommentTextEmpty, err)
+	_, err = useCase.CommentAction(
+		ctx, 1, 1, 2, "", nil)
+	assert.Nil(t, err)
+}
+
+func TestCommentUsecase_EditComment(t *testing.T) {
+	comment, err := useCase.CommentAction(
+		ctx, 1, 3, 3, "edited", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "edited", comment.Content)
+	assert.True(t, comment.IsEdited)
+	_, err = useCase.CommentAction(
+		ctx, 1, 3, 3, "", nil)
+	assert.Equal(t, ErrCommentTextEmpty, err)
+	_, err = useCase.CommentAction(
+		ctx, 1, 0, 3, "edited", nil)
+	assert.Equal(t, ErrInvalidId, err)
+}
+
+func TestCommentUsecase_ReactionAction(t *testing.T) {
+	err := useCase.ReactionAction(ctx, 4, 1, "👍")
+	assert.Nil(t, err)
+	err = useCase.ReactionAction(ctx, 4, 1, "👍")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), testCommentsData[4].Reactions[0].Count)
+	err = useCase.ReactionAction(ctx, 4, 2, "👍")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), testCommentsData[4].Reactions[0].Count)
+	err = useCase.ReactionAction(ctx, 4, 1, "")
+	assert.Equal(t, ErrEmojiEmpty, err)
+	err = useCase.ReactionAction(ctx, 4, 1, "abc")
+	assert.Equal(t, ErrInvalidEmoji, err)
+	err = useCase.ReactionAction(ctx, 4, 3, "👍")
+	assert.Equal(t, ErrInValidActionType, err)
 }
 
 func TestCommentUsecase_GetCommentList(t *testing.T) {
-	comments, err := useCase.GetCommentList(ctx, 1)
+	comments, err := useCase.GetCommentList(ctx, 1, time.Time{}, time.Time{})
 	assert.Nil(t, err)
 	assert.Equal(t, len(comments), len(testCommentsData))
 }
+
+func TestCommentUsecase_GetCommentListByTimeRange(t *testing.T) {
+	start := time.Date(2023, 8, 2, 0, 0, 0, 0, time.Local)
+	end := time.Date(2023, 8, 4, 0, 0, 0, 0, time.Local)
+	comments, err := useCase.GetCommentList(ctx, 1, start, end)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(comments))
+	for _, comment := range comments {
+		assert.False(t, comment.CreateTime.Before(start))
+		assert.False(t, comment.CreateTime.After(end))
+	}
+	_, err = useCase.GetCommentList(ctx, 1, end, start)
+	assert.ErrorIs(t, err, ErrInvalidTimeRange)
+}
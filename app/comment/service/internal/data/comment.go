@@ -1,17 +1,24 @@
 package data
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"math/rand"
+	"net/http"
 	"sort"
 	"strconv"
 	"time"
 
+	publishv1 "github.com/toomanysource/atreus/api/publish/service/v1"
+	relationv1 "github.com/toomanysource/atreus/api/relation/service/v1"
 	userv1 "github.com/toomanysource/atreus/api/user/service/v1"
 
-	"github.com/toomanysource/atreus/pkg/kafkaX"
+	"github.com/toomanysource/atreus/pkg/ffmpegX"
+	"github.com/toomanysource/atreus/pkg/outboxX"
 
 	"github.com/segmentio/kafka-go"
 
@@ -24,6 +31,9 @@ import (
 	"github.com/toomanysource/atreus/app/comment/service/internal/biz"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/minio/minio-go/v7"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 const (
@@ -31,38 +41,94 @@ const (
 	OccupyValue = ""
 )
 
+const ThumbnailSize = 240
+
+// imageExts 允许上传的评论图片类型及其文件后缀
+var imageExts = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
 type Comment struct {
-	Id       uint32 `gorm:"primary_key"`
-	UserId   uint32 `gorm:"column:user_id;not null"`
-	VideoId  uint32 `gorm:"column:video_id;not null;index:idx_video_id"`
-	Content  string `gorm:"column:content;not null"`
-	CreateAt string `gorm:"column:created_at;default:''" copier:"CreateDate"`
+	Id           uint32 `gorm:"primary_key"`
+	UserId       uint32 `gorm:"column:user_id;not null"`
+	VideoId      uint32 `gorm:"column:video_id;not null;index:idx_video_id;index:idx_video_create_time,priority:1"`
+	Content      string `gorm:"column:content;not null"`
+	CreateTime   int64  `gorm:"column:create_time;not null;default:0;index:idx_video_create_time,priority:2" copier:"-"`
+	IsEdited     bool   `gorm:"column:is_edited;not null;default:false"`
+	ImageKey     string `gorm:"column:image_key;not null;default:''"`
+	ThumbnailKey string `gorm:"column:thumbnail_key;not null;default:''"`
 }
 
 func (Comment) TableName() string {
 	return "comments"
 }
 
+// CommentHistory 评论编辑前的历史版本
+type CommentHistory struct {
+	Id        uint32 `gorm:"primary_key"`
+	CommentId uint32 `gorm:"column:comment_id;not null;index:idx_comment_id"`
+	Content   string `gorm:"column:content;not null"`
+	EditTime  int64  `gorm:"column:edit_time;not null"`
+}
+
+func (CommentHistory) TableName() string {
+	return "comment_histories"
+}
+
 type UserRepo interface {
 	GetUserInfos(context.Context, uint32, []uint32) ([]*biz.User, error)
 }
 
+type PublishRepo interface {
+	GetAuthorId(ctx context.Context, userId, videoId uint32) (uint32, error)
+}
+
+type RelationRepo interface {
+	IsBlockedByAuthor(ctx context.Context, userId, authorId uint32) (bool, error)
+}
+
 type commentRepo struct {
-	data     *Data
-	kfk      *kafka.Writer
-	userRepo UserRepo
-	log      *log.Helper
+	data         *Data
+	kfk          *kafka.Writer
+	userRepo     UserRepo
+	publishRepo  PublishRepo
+	relationRepo RelationRepo
+	log          *log.Helper
 }
 
 func NewCommentRepo(
-	data *Data, userConn userv1.UserServiceClient, logger log.Logger,
+	data *Data, userConn userv1.UserServiceClient, publishConn publishv1.PublishServiceClient,
+	relationConn relationv1.RelationServiceClient, logger log.Logger,
 ) biz.CommentRepo {
 	return &commentRepo{
-		data:     data,
-		kfk:      data.kfk,
-		userRepo: NewUserRepo(userConn),
-		log:      log.NewHelper(log.With(logger, "model", "data/comment")),
+		data:         data,
+		kfk:          data.kfk,
+		userRepo:     NewUserRepo(userConn),
+		publishRepo:  NewPublishRepo(publishConn),
+		relationRepo: NewRelationRepo(relationConn),
+		log:          log.NewHelper(log.With(logger, "model", "data/comment")),
+	}
+}
+
+// checkBlocked 检查用户是否被视频作者屏蔽
+func (r *commentRepo) checkBlocked(ctx context.Context, userId, videoId uint32) error {
+	authorId, err := r.publishRepo.GetAuthorId(ctx, userId, videoId)
+	if err != nil {
+		return err
+	}
+	if authorId == userId {
+		return nil
+	}
+	blocked, err := r.relationRepo.IsBlockedByAuthor(ctx, userId, authorId)
+	if err != nil {
+		return err
 	}
+	if blocked {
+		return ErrBlocked
+	}
+	return nil
 }
 
 // DeleteComment 删除评论
@@ -83,6 +149,13 @@ func (r *commentRepo) DeleteComment(
 		}
 	}()
 
+	go func() {
+		if err = r.DeleteReactions(context.Background(), commentId); err != nil {
+			r.log.Error(err)
+			return
+		}
+	}()
+
 	r.log.Infof(
 		"DeleteComment -> videoId: %v - userId: %v - commentId: %v", videoId, userId, commentId)
 	return nil, nil
@@ -90,12 +163,26 @@ func (r *commentRepo) DeleteComment(
 
 // CreateComment 创建评论
 func (r *commentRepo) CreateComment(
-	ctx context.Context, videoId uint32, commentText string,
+	ctx context.Context, videoId uint32, commentText string, imageData []byte,
 ) (*biz.Comment, error) {
 	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
-	// 先在数据库中插入关系
-	co, err := r.InsertComment(ctx, videoId, commentText, userId)
+	// 被视频作者屏蔽时不能评论
+	if err := r.checkBlocked(ctx, userId, videoId); err != nil {
+		return nil, err
+	}
+	// 如果附带图片，校验后先上传图片及缩略图
+	var imageKey, thumbnailKey string
+	if len(imageData) != 0 {
+		var err error
+		imageKey, thumbnailKey, err = r.UploadCommentImage(ctx, userId, imageData)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// 先在数据库中插入关系，插入失败时删除已上传的图片
+	co, err := r.InsertComment(ctx, videoId, commentText, userId, imageKey, thumbnailKey)
 	if err != nil {
+		r.RemoveCommentImages(imageKey, thumbnailKey)
 		return nil, err
 	}
 
@@ -122,15 +209,68 @@ func (r *commentRepo) CreateComment(
 		return nil, errors.Join(ErrCopy, err)
 	}
 	c.User = user
+	c.CreateTime = time.Unix(co.CreateTime, 0)
+	if c.ImageUrl, c.ThumbnailUrl, err = r.GetImageUrls(ctx, co); err != nil {
+		return nil, err
+	}
 
 	r.log.Infof(
 		"CreateComment -> videoId: %v - userId: %v - comment: %v", videoId, userId, commentText)
 	return c, nil
 }
 
-// GetComments 获取评论列表
+// EditComment 编辑评论
+func (r *commentRepo) EditComment(
+	ctx context.Context, videoId, commentId uint32, commentText string, since time.Time,
+) (*biz.Comment, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	// 先在数据库中更新评论并保存历史版本
+	co, err := r.UpdateComment(ctx, videoId, commentId, userId, commentText, since)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		if err = r.UpdateCache(context.Background(), videoId, co); err != nil {
+			r.log.Error(err)
+			return
+		}
+		r.log.Info("redis update success")
+	}()
+
+	users, err := r.userRepo.GetUserInfos(ctx, userId, []uint32{userId})
+	if err != nil {
+		return nil, err
+	}
+	user := new(biz.User)
+	err = copier.Copy(user, users[0])
+	if err != nil {
+		return nil, errors.Join(ErrCopy, err)
+	}
+	user.IsFollow = false
+	c := new(biz.Comment)
+	if err = copier.Copy(c, co); err != nil {
+		return nil, errors.Join(ErrCopy, err)
+	}
+	c.User = user
+	c.CreateTime = time.Unix(co.CreateTime, 0)
+	if c.ImageUrl, c.ThumbnailUrl, err = r.GetImageUrls(ctx, co); err != nil {
+		return nil, err
+	}
+	reactions, err := r.GetReactionsByCommentIds(ctx, userId, []uint32{co.Id})
+	if err != nil {
+		return nil, err
+	}
+	c.Reactions = reactions[co.Id]
+
+	r.log.Infof(
+		"EditComment -> videoId: %v - userId: %v - commentId: %v - comment: %v", videoId, userId, commentId, commentText)
+	return c, nil
+}
+
+// GetComments 获取评论列表，start和end为零值时表示不限制对应的时间边界
 func (r *commentRepo) GetComments(
-	ctx context.Context, videoId uint32,
+	ctx context.Context, videoId uint32, start, end time.Time,
 ) (cls []*biz.Comment, err error) {
 	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
 	// 先在redis缓存中查询是否存在视频评论列表
@@ -140,26 +280,28 @@ func (r *commentRepo) GetComments(
 	}
 	var cl []*Comment
 	if ok {
-		// 如果存在则直接返回
+		// 如果存在则直接返回，缓存中保存的是完整列表，按时间范围在内存中过滤
 		cl, err = r.GetCache(ctx, videoId)
 		if err != nil {
 			return nil, err
 		}
+		cl = filterComments(cl, start, end)
 	} else {
-		cl, err = r.GetCommentsByVideoId(ctx, videoId)
+		cl, err = r.GetCommentsByVideoId(ctx, videoId, start, end)
 		if err != nil {
 			return nil, err
 		}
-
-		go func(l []*Comment) {
-			if err = r.CreateCacheByTrans(context.Background(), l, videoId); err != nil {
-				r.log.Error(err)
-				return
-			}
-			r.log.Info("redis transaction success")
-		}(cl)
+		// 按时间范围查询到的不是完整列表，不创建缓存
+		if start.IsZero() && end.IsZero() {
+			go func(l []*Comment) {
+				if err = r.CreateCacheByTrans(context.Background(), l, videoId); err != nil {
+					r.log.Error(err)
+					return
+				}
+				r.log.Info("redis transaction success")
+			}(cl)
+		}
 	}
-
 	if len(cl) == 0 {
 		return nil, nil
 	}
@@ -174,13 +316,29 @@ func (r *commentRepo) GetComments(
 	if err != nil {
 		return nil, err
 	}
+	commentIds := make([]uint32, 0, len(cl))
+	for _, comment := range cl {
+		commentIds = append(commentIds, comment.Id)
+	}
+	reactions, err := r.GetReactionsByCommentIds(ctx, userId, commentIds)
+	if err != nil {
+		return nil, err
+	}
 	cls = make([]*biz.Comment, 0, len(cl))
 	for i, comment := range cl {
+		imageUrl, thumbnailUrl, err := r.GetImageUrls(ctx, comment)
+		if err != nil {
+			return nil, err
+		}
 		cls = append(cls, &biz.Comment{
-			Id:         comment.Id,
-			User:       users[i],
-			Content:    comment.Content,
-			CreateDate: comment.CreateAt,
+			Id:           comment.Id,
+			User:         users[i],
+			Content:      comment.Content,
+			CreateTime:   time.Unix(comment.CreateTime, 0),
+			IsEdited:     comment.IsEdited,
+			ImageUrl:     imageUrl,
+			ThumbnailUrl: thumbnailUrl,
+			Reactions:    reactions[comment.Id],
 		})
 	}
 	sortComments(cls)
@@ -210,6 +368,27 @@ func (r *commentRepo) InsertCache(ctx context.Context, videoId uint32, co *Comme
 	return nil
 }
 
+// UpdateCache 原地更新缓存中的评论
+func (r *commentRepo) UpdateCache(ctx context.Context, videoId uint32, co *Comment) error {
+	// 在redis缓存中查询是否存在该评论
+	ok, err := r.data.cache.HExists(ctx, strconv.Itoa(int(videoId)), strconv.Itoa(int(co.Id))).Result()
+	if err != nil {
+		return errors.Join(ErrRedisQuery, err)
+	}
+	if ok {
+		// 如果存在则直接覆盖该评论
+		marc, err := json.Marshal(co)
+		if err != nil {
+			return errors.Join(ErrJsonMarshal, err)
+		}
+		if err = r.data.cache.HSet(
+			ctx, strconv.Itoa(int(videoId)), strconv.Itoa(int(co.Id)), marc).Err(); err != nil {
+			return errors.Join(ErrRedisSet, err)
+		}
+	}
+	return nil
+}
+
 // DeleteCache 删除缓存
 func (r *commentRepo) DeleteCache(ctx context.Context, videoId, commentId uint32) error {
 	// 在redis缓存中查询是否存在
@@ -260,46 +439,100 @@ func (r *commentRepo) CheckCache(ctx context.Context, videoId uint32) (bool, err
 func (r *commentRepo) DeleteCommentById(
 	ctx context.Context, videoId, commentId uint32,
 ) error {
-	result := r.data.db.WithContext(ctx).Select("id").Delete(&Comment{}, commentId)
-	if result.Error != nil {
-		return errors.Join(ErrMysqlDelete, result.Error)
-	}
-	if result.RowsAffected == 0 {
-		return ErrInvalidComment
-	}
-	go func() {
-		if err := kafkaX.Update(r.kfk, strconv.Itoa(int(videoId)), "-1"); err != nil {
-			r.log.Error(err)
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Select("id").Delete(&Comment{}, commentId)
+		if result.Error != nil {
+			return errors.Join(ErrMysqlDelete, result.Error)
 		}
-	}()
-	return nil
+		if result.RowsAffected == 0 {
+			return ErrInvalidComment
+		}
+		// 评论数变更事件与评论在同一事务中写入发件箱
+		return outboxX.Add(tx, r.kfk.Topic, strconv.Itoa(int(videoId)), "-1")
+	})
 }
 
 // InsertComment 数据库插入评论
 func (r *commentRepo) InsertComment(
-	ctx context.Context, videoId uint32, commentText string, userId uint32,
+	ctx context.Context, videoId uint32, commentText string, userId uint32, imageKey, thumbnailKey string,
 ) (*Comment, error) {
+	now := time.Now()
 	comment := &Comment{
-		UserId:   userId,
-		VideoId:  videoId,
-		Content:  commentText,
-		CreateAt: time.Now().Format("01-02"),
+		UserId:       userId,
+		VideoId:      videoId,
+		Content:      commentText,
+		CreateTime:   now.Unix(),
+		ImageKey:     imageKey,
+		ThumbnailKey: thumbnailKey,
 	}
-	if err := r.data.db.WithContext(ctx).Create(comment).Error; err != nil {
-		return nil, errors.Join(ErrMysqlInsert, err)
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(comment).Error; err != nil {
+			return errors.Join(ErrMysqlInsert, err)
+		}
+		// 评论数变更事件与评论在同一事务中写入发件箱
+		return outboxX.Add(tx, r.kfk.Topic, strconv.Itoa(int(videoId)), "1")
+	})
+	if err != nil {
+		return nil, err
 	}
-	go func() {
-		strconv.Itoa(int(videoId))
-		if err := kafkaX.Update(r.kfk, strconv.Itoa(int(videoId)), "1"); err != nil {
-			r.log.Error(err)
+	return comment, nil
+}
+
+// UpdateComment 数据库更新评论，并在同一事务中保存编辑前的历史版本
+func (r *commentRepo) UpdateComment(
+	ctx context.Context, videoId, commentId, userId uint32, commentText string, since time.Time,
+) (*Comment, error) {
+	comment := &Comment{}
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND video_id = ? AND user_id = ?", commentId, videoId, userId).
+			Take(comment).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrInvalidComment
 		}
-	}()
+		if err != nil {
+			return errors.Join(ErrMysqlQuery, err)
+		}
+		if comment.CreateTime < since.Unix() {
+			return biz.ErrEditWindowExpired
+		}
+		history := &CommentHistory{
+			CommentId: comment.Id,
+			Content:   comment.Content,
+			EditTime:  time.Now().Unix(),
+		}
+		if err = tx.Model(&CommentHistory{}).Create(history).Error; err != nil {
+			return errors.Join(ErrMysqlInsert, err)
+		}
+		comment.Content = commentText
+		comment.IsEdited = true
+		err = tx.Model(&Comment{}).Where("id = ?", comment.Id).
+			Updates(map[string]interface{}{"content": comment.Content, "is_edited": comment.IsEdited}).Error
+		if err != nil {
+			return errors.Join(ErrMysqlUpdate, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 	return comment, nil
 }
 
-// GetCommentsByVideoId 数据库搜索评论列表
-func (r *commentRepo) GetCommentsByVideoId(ctx context.Context, videoId uint32) (c []*Comment, err error) {
-	if err = r.data.db.WithContext(ctx).Where("video_id = ?", videoId).Find(&c).Error; err != nil {
+// GetCommentsByVideoId 数据库搜索发布时间在[start, end]范围内的评论列表，start和end为零值时表示不限制对应的时间边界
+func (r *commentRepo) GetCommentsByVideoId(
+	ctx context.Context, videoId uint32, start, end time.Time,
+) (c []*Comment, err error) {
+	db := r.data.db.WithContext(ctx).Where("video_id = ?", videoId)
+	switch {
+	case !start.IsZero() && !end.IsZero():
+		db = db.Where("create_time BETWEEN ? AND ?", start.Unix(), end.Unix())
+	case !start.IsZero():
+		db = db.Where("create_time >= ?", start.Unix())
+	case !end.IsZero():
+		db = db.Where("create_time <= ?", end.Unix())
+	}
+	if err = db.Find(&c).Error; err != nil {
 		return nil, errors.Join(ErrMysqlQuery, err)
 	}
 	return c, nil
@@ -337,15 +570,112 @@ func (r *commentRepo) CreateCacheByTrans(ctx context.Context, cl []*Comment, vid
 	return nil
 }
 
+// UploadCommentImage 上传评论图片及其缩略图，返回两者在minio中的文件名
+func (r *commentRepo) UploadCommentImage(
+	ctx context.Context, userId uint32, imageData []byte,
+) (imageKey, thumbnailKey string, err error) {
+	contentType := http.DetectContentType(imageData)
+	ext, ok := imageExts[contentType]
+	if !ok {
+		return "", "", ErrInvalidImage
+	}
+	// 生成缩略图，同时校验图片能否正常解码
+	thumbnail, err := ffmpegX.GenerateThumbnail(bytes.NewReader(imageData), ThumbnailSize, ThumbnailSize)
+	if err != nil {
+		return "", "", errors.Join(ErrInvalidImage, err)
+	}
+	thumbnailData, err := io.ReadAll(thumbnail)
+	if err != nil {
+		return "", "", errors.Join(ErrFileRead, err)
+	}
+	fileName := fmt.Sprintf("%d-%d", userId, time.Now().UnixNano())
+	imageKey = "comments/images/" + fileName + ext
+	thumbnailKey = "comments/thumbnails/" + fileName + ".jpg"
+	imageReader := bytes.NewReader(imageData)
+	err = r.data.oss.UploadSizeFile(
+		ctx, r.data.bucket, imageKey, imageReader, imageReader.Size(), minio.PutObjectOptions{
+			ContentType: contentType,
+		},
+	)
+	if err != nil {
+		return "", "", err
+	}
+	thumbnailReader := bytes.NewReader(thumbnailData)
+	err = r.data.oss.UploadSizeFile(
+		ctx, r.data.bucket, thumbnailKey, thumbnailReader, thumbnailReader.Size(), minio.PutObjectOptions{
+			ContentType: "image/jpeg",
+		},
+	)
+	if err != nil {
+		r.RemoveCommentImages(imageKey)
+		return "", "", err
+	}
+	return imageKey, thumbnailKey, nil
+}
+
+// RemoveCommentImages 删除未能保存到评论中的图片，删除失败只记录日志。
+// 上传请求可能已被取消，删除不使用请求的ctx
+func (r *commentRepo) RemoveCommentImages(keys ...string) {
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		if err := r.data.oss.RemoveFile(context.Background(), r.data.bucket, key); err != nil {
+			r.log.Errorf("remove comment image %v err: %v", key, err)
+		}
+	}
+}
+
+// GetImageUrls 获取评论图片及缩略图的url，没有图片时返回空字符串
+func (r *commentRepo) GetImageUrls(ctx context.Context, co *Comment) (imageUrl, thumbnailUrl string, err error) {
+	if co.ImageKey == "" {
+		return "", "", nil
+	}
+	hours, days := 24, 7
+	urls, err := r.data.oss.GetFileURL(
+		ctx, r.data.bucket, co.ImageKey, time.Hour*time.Duration(hours*days))
+	if err != nil {
+		return "", "", fmt.Errorf("get comment image url err, %w", err)
+	}
+	imageUrl = urls.String()
+	urls, err = r.data.oss.GetFileURL(
+		ctx, r.data.bucket, co.ThumbnailKey, time.Hour*time.Duration(hours*days))
+	if err != nil {
+		return "", "", fmt.Errorf("get comment thumbnail url err, %w", err)
+	}
+	thumbnailUrl = urls.String()
+	return
+}
+
 // randomTime 随机生成时间
 func randomTime(timeType time.Duration, begin, end int) time.Duration {
 	return timeType * time.Duration(rand.Intn(end-begin+1)+begin)
 }
 
-// sortComments 对评论列表进行排序
+// filterComments 过滤出发布时间在[start, end]范围内的评论
+func filterComments(cl []*Comment, start, end time.Time) []*Comment {
+	if start.IsZero() && end.IsZero() {
+		return cl
+	}
+	fl := make([]*Comment, 0, len(cl))
+	for _, v := range cl {
+		if !start.IsZero() && v.CreateTime < start.Unix() {
+			continue
+		}
+		if !end.IsZero() && v.CreateTime > end.Unix() {
+			continue
+		}
+		fl = append(fl, v)
+	}
+	return fl
+}
+
+// sortComments 对评论列表按发布时间倒序排序，同一时间按id倒序
 func sortComments(cl []*biz.Comment) {
-	// 对原始切片进行排序
 	sort.Slice(cl, func(i, j int) bool {
+		if !cl[i].CreateTime.Equal(cl[j].CreateTime) {
+			return cl[i].CreateTime.After(cl[j].CreateTime)
+		}
 		return cl[i].Id > cl[j].Id
 	})
 }
@@ -0,0 +1,106 @@
+package data
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/toomanysource/atreus/app/comment/service/internal/biz"
+	"github.com/toomanysource/atreus/pkg/sqlmockX"
+)
+
+var commentColumns = []string{
+	"id", "user_id", "video_id", "content", "create_time", "is_edited", "image_key", "thumbnail_key",
+}
+
+func newMockCommentRepo(t *testing.T) (*commentRepo, *sqlmockX.Mock) {
+	db, mock := sqlmockX.New(t)
+	return &commentRepo{
+		data: &Data{db: db},
+		log:  log.NewHelper(log.DefaultLogger),
+	}, mock
+}
+
+func commentRows(createTime int64) *sqlmockX.Rows {
+	return &sqlmockX.Rows{
+		Columns: commentColumns,
+		Values:  [][]driver.Value{{int64(1), int64(2), int64(3), "old", createTime, false, "", ""}},
+	}
+}
+
+func TestCommentRepo_UpdateComment(t *testing.T) {
+	ctx := context.Background()
+	since := time.Now().Add(-5 * time.Minute)
+
+	repo, mock := newMockCommentRepo(t)
+	mock.ExpectQuery("FOR UPDATE", commentRows(time.Now().Unix()))
+	comment, err := repo.UpdateComment(ctx, 3, 1, 2, "new", since)
+	assert.Nil(t, err)
+	assert.Equal(t, "new", comment.Content)
+	assert.True(t, comment.IsEdited)
+	// 修改前的内容写入历史，评论只更新内容和编辑标记
+	histories := mock.Inserts("comment_histories")
+	assert.Equal(t, 1, len(histories))
+	assert.Equal(t, "old", histories[0]["content"])
+	assert.Equal(t, []map[string]driver.Value{{"content": "new", "is_edited": true}}, mock.Updates("comments"))
+
+	repo, mock = newMockCommentRepo(t)
+	mock.ExpectQuery("FOR UPDATE", commentRows(since.Add(-time.Minute).Unix()))
+	_, err = repo.UpdateComment(ctx, 3, 1, 2, "new", since)
+	assert.ErrorIs(t, err, biz.ErrEditWindowExpired)
+	assert.Empty(t, mock.Updates("comments"))
+	assert.Empty(t, mock.Inserts("comment_histories"))
+
+	repo, mock = newMockCommentRepo(t)
+	_, err = repo.UpdateComment(ctx, 3, 1, 2, "new", since)
+	assert.ErrorIs(t, err, ErrInvalidComment)
+	assert.Empty(t, mock.Updates("comments"))
+}
+
+func TestCommentRepo_GetCommentsByVideoId(t *testing.T) {
+	ctx := context.Background()
+	start, end := time.Unix(100, 0), time.Unix(200, 0)
+	tests := []struct {
+		start, end time.Time
+		where      string
+		args       []driver.Value
+	}{
+		{time.Time{}, time.Time{}, "WHERE video_id = ?", []driver.Value{int64(3)}},
+		{start, end, "create_time BETWEEN ? AND ?", []driver.Value{int64(3), int64(100), int64(200)}},
+		{start, time.Time{}, "create_time >= ?", []driver.Value{int64(3), int64(100)}},
+		{time.Time{}, end, "create_time <= ?", []driver.Value{int64(3), int64(200)}},
+	}
+	for _, tt := range tests {
+		repo, mock := newMockCommentRepo(t)
+		_, err := repo.GetCommentsByVideoId(ctx, 3, tt.start, tt.end)
+		assert.Nil(t, err)
+		queries := mock.Selects("comments")
+		assert.Equal(t, 1, len(queries))
+		assert.Contains(t, queries[0].SQL, tt.where)
+		assert.Equal(t, tt.args, queries[0].Args)
+	}
+}
+
+func TestParseLegacyCreateTimes(t *testing.T) {
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.Local)
+	createTimes, err := parseLegacyCreateTimes([]*legacyComment{
+		{Id: 1, CreateAt: "02-28"},
+		{Id: 2, CreateAt: "12-31"},
+	}, now)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2023, 2, 28, 0, 0, 0, 0, time.Local).Unix(), createTimes[1])
+	// 晚于当前日期的为去年
+	assert.Equal(t, time.Date(2022, 12, 31, 0, 0, 0, 0, time.Local).Unix(), createTimes[2])
+
+	// 任意一条无法解析时放弃迁移
+	_, err = parseLegacyCreateTimes([]*legacyComment{
+		{Id: 1, CreateAt: "02-28"},
+		{Id: 3, CreateAt: "2023/02/28"},
+	}, now)
+	assert.ErrorIs(t, err, ErrLegacyCreateTime)
+	assert.Contains(t, err.Error(), "[3]")
+}
@@ -3,21 +3,27 @@ package data
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/segmentio/kafka-go"
 
 	"github.com/toomanysource/atreus/app/comment/service/internal/conf"
+	"github.com/toomanysource/atreus/pkg/minioX"
+	"github.com/toomanysource/atreus/pkg/outboxX"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
 	"github.com/google/wire"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
 
-var ProviderSet = wire.NewSet(NewData, NewKafkaWriter, NewCommentRepo, NewUserRepo, NewMysqlConn, NewRedisConn)
+var ProviderSet = wire.NewSet(NewData, NewKafkaWriter, NewCommentRepo, NewUserRepo, NewMysqlConn, NewRedisConn, NewMinioConn, NewMinioExtraConn, NewMinioIntraConn)
 
 var (
 	ErrInvalidComment      = errors.New("invalid comment")
@@ -27,23 +33,42 @@ var (
 	ErrRedisQuery          = errors.New("redis query error")
 	ErrMysqlDelete         = errors.New("mysql delete error")
 	ErrMysqlInsert         = errors.New("mysql insert error")
+	ErrMysqlUpdate         = errors.New("mysql update error")
 	ErrMysqlQuery          = errors.New("mysql query error")
 	ErrRedisDelete         = errors.New("redis delete error")
 	ErrRedisTransaction    = errors.New("redis transaction error")
 	ErrUserServiceResponse = errors.New("user service response error")
+	ErrInvalidImage        = errors.New("invalid image")
+	ErrFileRead            = errors.New("file read error")
+	ErrInvalidVideo        = errors.New("invalid video")
+	ErrBlocked             = errors.New("you are blocked by the video author")
+	ErrLegacyCreateTime    = errors.New("invalid legacy created_at")
+
+	ErrPublishServiceResponse  = errors.New("publish service response error")
+	ErrRelationServiceResponse = errors.New("relation service response error")
 )
 
 type Data struct {
-	db    *gorm.DB
-	cache *redis.Client
-	kfk   *kafka.Writer
-	log   *log.Helper
+	db     *gorm.DB
+	cache  *redis.Client
+	kfk    *kafka.Writer
+	oss    *minioX.Client
+	bucket string
+	log    *log.Helper
 }
 
-func NewData(db *gorm.DB, cacheClient *redis.Client, kfk *kafka.Writer, logger log.Logger) (*Data, func(), error) {
+func NewData(
+	db *gorm.DB, cacheClient *redis.Client, kfk *kafka.Writer,
+	minioClient *minioX.Client, c *conf.Minio, logger log.Logger,
+) (*Data, func(), error) {
 	logHelper := log.NewHelper(log.With(logger, "module", "data/data"))
+	// 发件箱中的评论数变更事件由relay投递到Kafka
+	relay := outboxX.NewRelay(db, logger, kfk)
+	relay.Start()
 	// 并发关闭所有数据库连接
 	cleanup := func() {
+		// 先停止投递再关闭Kafka连接
+		relay.Stop()
 		var wg sync.WaitGroup
 		wg.Add(1)
 		go func() {
@@ -70,10 +95,12 @@ func NewData(db *gorm.DB, cacheClient *redis.Client, kfk *kafka.Writer, logger l
 	}
 
 	data := &Data{
-		db:    db.Model(&Comment{}),
-		cache: cacheClient,
-		kfk:   kfk,
-		log:   logHelper,
+		db:     db.Model(&Comment{}),
+		cache:  cacheClient,
+		kfk:    kfk,
+		oss:    minioClient,
+		bucket: c.BucketName,
+		log:    logHelper,
 	}
 	return data, cleanup, nil
 }
@@ -112,6 +139,40 @@ func NewRedisConn(c *conf.Data, l log.Logger) *redis.Client {
 	return client
 }
 
+func NewMinioConn(c *conf.Minio, extraConn minioX.ExtraConn, intraConn minioX.IntraConn, l log.Logger) *minioX.Client {
+	logs := log.NewHelper(log.With(l, "module", "data/data/minio"))
+	client := minioX.NewClient(extraConn, intraConn)
+	if err := client.CreateBucket(context.Background(), c.BucketName); err != nil {
+		logs.Fatal(err)
+	}
+	logs.Info("minio enabled successfully")
+	return client
+}
+
+func NewMinioExtraConn(c *conf.Minio, l log.Logger) minioX.ExtraConn {
+	logs := log.NewHelper(log.With(l, "module", "data/data/minioExtra"))
+	extraConn, err := minio.New(c.EndpointExtra, &minio.Options{
+		Creds:  credentials.NewStaticV4(c.AccessKeyId, c.AccessSecret, ""),
+		Secure: c.UseSsl,
+	})
+	if err != nil {
+		logs.Fatalf("minio client init failed,err: %v", err)
+	}
+	return minioX.NewExtraConn(extraConn)
+}
+
+func NewMinioIntraConn(c *conf.Minio, l log.Logger) minioX.IntraConn {
+	logs := log.NewHelper(log.With(l, "module", "data/data/minioIntra"))
+	intraConn, err := minio.New(c.EndpointIntra, &minio.Options{
+		Creds:  credentials.NewStaticV4(c.AccessKeyId, c.AccessSecret, ""),
+		Secure: c.UseSsl,
+	})
+	if err != nil {
+		logs.Fatalf("minio client init failed,err: %v", err)
+	}
+	return minioX.NewIntraConn(intraConn)
+}
+
 func NewKafkaWriter(c *conf.Data, l log.Logger) *kafka.Writer {
 	logs := log.NewHelper(log.With(l, "module", "data/data/kafka"))
 	writer := &kafka.Writer{
@@ -126,9 +187,80 @@ func NewKafkaWriter(c *conf.Data, l log.Logger) *kafka.Writer {
 	return writer
 }
 
-// InitDB 创建Comments数据表，并自动迁移
+// InitDB 创建Comments、CommentHistories、CommentReactions和发件箱数据表，并自动迁移
 func InitDB(db *gorm.DB) {
-	if err := db.AutoMigrate(&Comment{}); err != nil {
+	if err := db.AutoMigrate(&Comment{}, &CommentHistory{}, &CommentReaction{}); err != nil {
 		log.Fatalf("database initialization error, err : %v", err)
 	}
+	if err := outboxX.InitDB(db); err != nil {
+		log.Fatalf("outbox initialization error, err : %v", err)
+	}
+	// 旧数据无法解析时保留旧列，不影响服务启动
+	if err := migrateCreateTime(db); errors.Is(err, ErrLegacyCreateTime) {
+		log.Errorf("created_at migration aborted, err : %v", err)
+	} else if err != nil {
+		log.Fatalf("database migration error, err : %v", err)
+	}
+}
+
+// migrateCreateTime 将旧版本以"mm-dd"字符串保存的created_at列迁移到create_time时间戳列，
+// 旧数据没有年份信息，按距今最近的过去日期推算，迁移完成后删除created_at列。
+// 存在无法解析的旧数据时放弃迁移并保留created_at列，修正数据后重启时重新迁移
+func migrateCreateTime(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&Comment{}, "created_at") {
+		return nil
+	}
+	var legacy []*legacyComment
+	err := db.Model(&Comment{}).Select("id, created_at").
+		Where("create_time = ? AND created_at <> ?", 0, "").Find(&legacy).Error
+	if err != nil {
+		return errors.Join(ErrMysqlQuery, err)
+	}
+	createTimes, err := parseLegacyCreateTimes(legacy, time.Now())
+	if err != nil {
+		return err
+	}
+	err = db.Transaction(func(tx *gorm.DB) error {
+		for id, createTime := range createTimes {
+			err = tx.Model(&Comment{}).Where("id = ?", id).Update("create_time", createTime).Error
+			if err != nil {
+				return errors.Join(ErrMysqlUpdate, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	// DDL语句会隐式提交事务，数据迁移提交后再删除旧列
+	return db.Migrator().DropColumn(&Comment{}, "created_at")
+}
+
+// legacyComment 旧版本评论的创建日期
+type legacyComment struct {
+	Id       uint32
+	CreateAt string `gorm:"column:created_at"`
+}
+
+// parseLegacyCreateTimes 将旧数据的"mm-dd"日期推算为now之前最近的时间戳，以评论id为key，
+// 任意一条无法解析时返回全部无法解析的评论id
+func parseLegacyCreateTimes(legacy []*legacyComment, now time.Time) (map[uint32]int64, error) {
+	createTimes := make(map[uint32]int64, len(legacy))
+	var invalid []uint32
+	for _, v := range legacy {
+		t, err := time.ParseInLocation("01-02", v.CreateAt, now.Location())
+		if err != nil {
+			invalid = append(invalid, v.Id)
+			continue
+		}
+		t = t.AddDate(now.Year()-t.Year(), 0, 0)
+		if t.After(now) {
+			t = t.AddDate(-1, 0, 0)
+		}
+		createTimes[v.Id] = t.Unix()
+	}
+	if len(invalid) != 0 {
+		return nil, fmt.Errorf("%w, comment ids: %v", ErrLegacyCreateTime, invalid)
+	}
+	return createTimes, nil
 }
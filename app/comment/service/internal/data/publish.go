@@ -0,0 +1,31 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/toomanysource/atreus/api/publish/service/v1"
+)
+
+type publishRepo struct {
+	client pb.PublishServiceClient
+}
+
+func NewPublishRepo(conn pb.PublishServiceClient) PublishRepo {
+	return &publishRepo{
+		client: conn,
+	}
+}
+
+// GetAuthorId 通过Publish服务获取视频作者id
+func (p *publishRepo) GetAuthorId(ctx context.Context, userId, videoId uint32) (uint32, error) {
+	resp, err := p.client.GetVideoListByVideoIds(
+		ctx, &pb.VideoListByVideoIdsRequest{UserId: userId, VideoIds: []uint32{videoId}})
+	if err != nil {
+		return 0, errors.Join(ErrPublishServiceResponse, err)
+	}
+	if len(resp.VideoList) == 0 || resp.VideoList[0].Author == nil {
+		return 0, ErrInvalidVideo
+	}
+	return resp.VideoList[0].Author.Id, nil
+}
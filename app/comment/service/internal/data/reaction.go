@@ -0,0 +1,244 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm/clause"
+
+	"github.com/toomanysource/atreus/app/comment/service/internal/biz"
+	"github.com/toomanysource/atreus/middleware"
+)
+
+const reactionKeyPrefix = "reaction:"
+
+// incrReactionScript 缓存存在时原子地增减表情回应计数，计数不大于0时删除该表情，避免判断存在后缓存过期写入残缺的计数
+var incrReactionScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+if redis.call("HINCRBY", KEYS[1], ARGV[1], ARGV[2]) <= 0 then
+	redis.call("HDEL", KEYS[1], ARGV[1])
+end
+return 1`)
+
+// CommentReaction 评论表情回应
+type CommentReaction struct {
+	Id        uint32 `gorm:"primary_key"`
+	CommentId uint32 `gorm:"column:comment_id;not null;uniqueIndex:idx_comment_user_emoji"`
+	UserId    uint32 `gorm:"column:user_id;not null;uniqueIndex:idx_comment_user_emoji"`
+	Emoji     string `gorm:"column:emoji;size:32;not null;uniqueIndex:idx_comment_user_emoji"`
+}
+
+func (CommentReaction) TableName() string {
+	return "comment_reactions"
+}
+
+// reactionCount 表情回应聚合结果
+type reactionCount struct {
+	CommentId uint32
+	Emoji     string
+	Count     uint32
+}
+
+// AddReaction 为评论添加表情回应，重复回应不会重复计数
+func (r *commentRepo) AddReaction(ctx context.Context, commentId uint32, emoji string) error {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	var count int64
+	if err := r.data.db.WithContext(ctx).Model(&Comment{}).Where("id = ?", commentId).Count(&count).Error; err != nil {
+		return errors.Join(ErrMysqlQuery, err)
+	}
+	if count == 0 {
+		return ErrInvalidComment
+	}
+	result := r.data.db.WithContext(ctx).Model(&CommentReaction{}).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&CommentReaction{CommentId: commentId, UserId: userId, Emoji: emoji})
+	if result.Error != nil {
+		return errors.Join(ErrMysqlInsert, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil
+	}
+	go func() {
+		if err := r.UpdateReactionCache(context.Background(), commentId, emoji, 1); err != nil {
+			r.log.Error(err)
+		}
+	}()
+	r.log.Infof("AddReaction -> commentId: %v - userId: %v - emoji: %v", commentId, userId, emoji)
+	return nil
+}
+
+// RemoveReaction 取消评论的表情回应
+func (r *commentRepo) RemoveReaction(ctx context.Context, commentId uint32, emoji string) error {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	result := r.data.db.WithContext(ctx).Model(&CommentReaction{}).
+		Where("comment_id = ? AND user_id = ? AND emoji = ?", commentId, userId, emoji).
+		Delete(&CommentReaction{})
+	if result.Error != nil {
+		return errors.Join(ErrMysqlDelete, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil
+	}
+	go func() {
+		if err := r.UpdateReactionCache(context.Background(), commentId, emoji, -1); err != nil {
+			r.log.Error(err)
+		}
+	}()
+	r.log.Infof("RemoveReaction -> commentId: %v - userId: %v - emoji: %v", commentId, userId, emoji)
+	return nil
+}
+
+// DeleteReactions 删除评论的全部表情回应及其缓存
+func (r *commentRepo) DeleteReactions(ctx context.Context, commentId uint32) error {
+	err := r.data.db.WithContext(ctx).Model(&CommentReaction{}).
+		Where("comment_id = ?", commentId).Delete(&CommentReaction{}).Error
+	if err != nil {
+		return errors.Join(ErrMysqlDelete, err)
+	}
+	if err = r.data.cache.Del(ctx, reactionKey(commentId)).Err(); err != nil {
+		return errors.Join(ErrRedisDelete, err)
+	}
+	return nil
+}
+
+// UpdateReactionCache 缓存存在时增减表情回应计数
+func (r *commentRepo) UpdateReactionCache(ctx context.Context, commentId uint32, emoji string, incr int64) error {
+	err := incrReactionScript.Run(ctx, r.data.cache, []string{reactionKey(commentId)}, emoji, incr).Err()
+	if err != nil {
+		return errors.Join(ErrRedisSet, err)
+	}
+	return nil
+}
+
+// GetReactionsByCommentIds 批量获取评论的表情回应，优先读取缓存
+func (r *commentRepo) GetReactionsByCommentIds(
+	ctx context.Context, userId uint32, commentIds []uint32,
+) (map[uint32][]*biz.Reaction, error) {
+	reactions := make(map[uint32][]*biz.Reaction, len(commentIds))
+	if len(commentIds) == 0 {
+		return reactions, nil
+	}
+	counts := make(map[uint32]map[string]uint32, len(commentIds))
+	cmds := make([]*redis.StringStringMapCmd, 0, len(commentIds))
+	_, err := r.data.cache.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, id := range commentIds {
+			cmds = append(cmds, pipe.HGetAll(ctx, reactionKey(id)))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Join(ErrRedisQuery, err)
+	}
+	missIds := make([]uint32, 0, len(commentIds))
+	for i, cmd := range cmds {
+		m := cmd.Val()
+		if len(m) == 0 {
+			missIds = append(missIds, commentIds[i])
+			continue
+		}
+		counts[commentIds[i]] = make(map[string]uint32, len(m))
+		for emoji, v := range m {
+			if emoji == OccupyKey {
+				continue
+			}
+			n, err := strconv.Atoi(v)
+			if err != nil || n <= 0 {
+				continue
+			}
+			counts[commentIds[i]][emoji] = uint32(n)
+		}
+	}
+	// 缓存未命中的评论从数据库聚合并回填缓存
+	if len(missIds) != 0 {
+		var rcs []*reactionCount
+		err = r.data.db.WithContext(ctx).Model(&CommentReaction{}).
+			Select("comment_id, emoji, COUNT(*) AS count").
+			Where("comment_id IN ?", missIds).
+			Group("comment_id, emoji").Scan(&rcs).Error
+		if err != nil {
+			return nil, errors.Join(ErrMysqlQuery, err)
+		}
+		for _, id := range missIds {
+			counts[id] = make(map[string]uint32)
+		}
+		for _, rc := range rcs {
+			counts[rc.CommentId][rc.Emoji] = rc.Count
+		}
+		go func() {
+			for _, id := range missIds {
+				if err := r.CreateReactionCacheByTrans(context.Background(), id, counts[id]); err != nil {
+					r.log.Error(err)
+				}
+			}
+		}()
+	}
+	// 查询当前用户回应过的表情
+	var own []*CommentReaction
+	if userId != 0 {
+		err = r.data.db.WithContext(ctx).Model(&CommentReaction{}).
+			Where("comment_id IN ? AND user_id = ?", commentIds, userId).Find(&own).Error
+		if err != nil {
+			return nil, errors.Join(ErrMysqlQuery, err)
+		}
+	}
+	reacted := make(map[uint32]map[string]bool, len(own))
+	for _, v := range own {
+		if reacted[v.CommentId] == nil {
+			reacted[v.CommentId] = make(map[string]bool)
+		}
+		reacted[v.CommentId][v.Emoji] = true
+	}
+	for _, id := range commentIds {
+		rl := make([]*biz.Reaction, 0, len(counts[id]))
+		for emoji, count := range counts[id] {
+			rl = append(rl, &biz.Reaction{
+				Emoji:     emoji,
+				Count:     count,
+				IsReacted: reacted[id][emoji],
+			})
+		}
+		sort.Slice(rl, func(i, j int) bool {
+			if rl[i].Count != rl[j].Count {
+				return rl[i].Count > rl[j].Count
+			}
+			return rl[i].Emoji < rl[j].Emoji
+		})
+		reactions[id] = rl
+	}
+	return reactions, nil
+}
+
+// CreateReactionCacheByTrans 使用事务创建表情回应缓存
+func (r *commentRepo) CreateReactionCacheByTrans(ctx context.Context, commentId uint32, counts map[string]uint32) error {
+	_, err := r.data.cache.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		insertMap := make(map[string]interface{}, len(counts)+1)
+		insertMap[OccupyKey] = OccupyValue
+		for emoji, count := range counts {
+			insertMap[emoji] = count
+		}
+		if err := pipe.HMSet(ctx, reactionKey(commentId), insertMap).Err(); err != nil {
+			return errors.Join(ErrRedisSet, err)
+		}
+		// 使用随机过期时间防止缓存雪崩
+		begin, end := 360, 720
+		err := pipe.Expire(ctx, reactionKey(commentId), randomTime(time.Minute, begin, end)).Err()
+		if err != nil {
+			return errors.Join(ErrRedisSet, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Join(ErrRedisTransaction, err)
+	}
+	return nil
+}
+
+func reactionKey(commentId uint32) string {
+	return reactionKeyPrefix + strconv.Itoa(int(commentId))
+}
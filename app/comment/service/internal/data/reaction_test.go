@@ -0,0 +1,31 @@
+package data
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/toomanysource/atreus/middleware"
+	"github.com/toomanysource/atreus/pkg/sqlmockX"
+)
+
+func TestCommentRepo_AddReaction(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middleware.UserIdKey("user_id"), uint32(2))
+	existing := &sqlmockX.Rows{Columns: []string{"count"}, Values: [][]driver.Value{{int64(1)}}}
+
+	// 重复回应不计数，不更新缓存
+	repo, mock := newMockCommentRepo(t)
+	mock.ExpectQuery("FROM `comments`", existing)
+	mock.ExpectExec("INSERT INTO `comment_reactions`", 0)
+	assert.Nil(t, repo.AddReaction(ctx, 1, "👍"))
+	assert.Equal(t, []map[string]driver.Value{
+		{"comment_id": int64(1), "user_id": int64(2), "emoji": "👍"},
+	}, mock.Inserts("comment_reactions"))
+
+	// 评论不存在
+	repo, mock = newMockCommentRepo(t)
+	assert.ErrorIs(t, repo.AddReaction(ctx, 1, "👍"), ErrInvalidComment)
+	assert.Empty(t, mock.Inserts("comment_reactions"))
+}
@@ -0,0 +1,30 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/toomanysource/atreus/api/relation/service/v1"
+)
+
+type relationRepo struct {
+	client pb.RelationServiceClient
+}
+
+func NewRelationRepo(conn pb.RelationServiceClient) RelationRepo {
+	return &relationRepo{
+		client: conn,
+	}
+}
+
+// IsBlockedByAuthor 通过Relation服务判断视频作者是否屏蔽了评论用户
+func (r *relationRepo) IsBlockedByAuthor(ctx context.Context, userId, authorId uint32) (bool, error) {
+	resp, err := r.client.IsBlocked(ctx, &pb.IsBlockedRequest{UserId: userId, ToUserId: []uint32{authorId}})
+	if err != nil {
+		return false, errors.Join(ErrRelationServiceResponse, err)
+	}
+	if len(resp.IsBlocked) == 0 {
+		return false, ErrRelationServiceResponse
+	}
+	return resp.IsBlocked[0], nil
+}
@@ -15,11 +15,14 @@ import (
 	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
 	"github.com/hashicorp/consul/api"
 
+	publishv1 "github.com/toomanysource/atreus/api/publish/service/v1"
+	relationv1 "github.com/toomanysource/atreus/api/relation/service/v1"
 	userv1 "github.com/toomanysource/atreus/api/user/service/v1"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewUserClient, NewDiscovery, NewRegistrar)
+var ProviderSet = wire.NewSet(
+	NewGRPCServer, NewHTTPServer, NewUserClient, NewPublishClient, NewRelationClient, NewDiscovery, NewRegistrar)
 
 // NewUserClient 创建一个User服务客户端，接收User服务数据
 func NewUserClient(r registry.Discovery, logger log.Logger) userv1.UserServiceClient {
@@ -40,6 +43,44 @@ func NewUserClient(r registry.Discovery, logger log.Logger) userv1.UserServiceCl
 	return userv1.NewUserServiceClient(conn)
 }
 
+// NewPublishClient 创建一个Publish服务客户端，接收Publish服务数据
+func NewPublishClient(r registry.Discovery, logger log.Logger) publishv1.PublishServiceClient {
+	logs := log.NewHelper(log.With(logger, "module", "server/publish"))
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint("discovery:///atreus.publish.service"),
+		grpc.WithDiscovery(r),
+		grpc.WithMiddleware(
+			recovery.Recovery(),
+			logging.Client(logger),
+		),
+	)
+	if err != nil {
+		logs.Fatalf("publish service connect error, %v", err)
+	}
+	logs.Info("publish service connect successfully")
+	return publishv1.NewPublishServiceClient(conn)
+}
+
+// NewRelationClient 创建一个Relation服务客户端，接收Relation服务数据
+func NewRelationClient(r registry.Discovery, logger log.Logger) relationv1.RelationServiceClient {
+	logs := log.NewHelper(log.With(logger, "module", "server/relation"))
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint("discovery:///atreus.relation.service"),
+		grpc.WithDiscovery(r),
+		grpc.WithMiddleware(
+			recovery.Recovery(),
+			logging.Client(logger),
+		),
+	)
+	if err != nil {
+		logs.Fatalf("relation service connect error, %v", err)
+	}
+	logs.Info("relation service connect successfully")
+	return relationv1.NewRelationServiceClient(conn)
+}
+
 func NewDiscovery(conf *conf.Registry) registry.Discovery {
 	c := api.DefaultConfig()
 	c.Address = conf.Consul.Address
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/jinzhu/copier"
 
@@ -26,7 +27,14 @@ func NewCommentService(cu *biz.CommentUseCase, logger log.Logger) *CommentServic
 
 func (s *CommentService) GetCommentList(ctx context.Context, req *pb.CommentListRequest) (*pb.CommentListReply, error) {
 	reply := &pb.CommentListReply{StatusCode: CodeSuccess, StatusMsg: "success", CommentList: make([]*pb.Comment, 0)}
-	commentList, err := s.cu.GetCommentList(ctx, req.VideoId)
+	var start, end time.Time
+	if req.StartTime != 0 {
+		start = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime != 0 {
+		end = time.Unix(req.EndTime, 0)
+	}
+	commentList, err := s.cu.GetCommentList(ctx, req.VideoId, start, end)
 	if err != nil {
 		reply.StatusCode = CodeFailed
 		reply.StatusMsg = err.Error()
@@ -38,12 +46,15 @@ func (s *CommentService) GetCommentList(ctx context.Context, req *pb.CommentList
 		reply.StatusMsg = err.Error()
 		return reply, nil
 	}
+	for i, comment := range commentList {
+		reply.CommentList[i].CreateDate = comment.CreateTime.Format(DateLayout)
+	}
 	return reply, nil
 }
 
 func (s *CommentService) CommentAction(ctx context.Context, req *pb.CommentActionRequest) (*pb.CommentActionReply, error) {
 	reply := &pb.CommentActionReply{StatusCode: CodeSuccess, StatusMsg: "success", Comment: &pb.Comment{}}
-	comment, err := s.cu.CommentAction(ctx, req.VideoId, req.CommentId, req.ActionType, req.CommentText)
+	comment, err := s.cu.CommentAction(ctx, req.VideoId, req.CommentId, req.ActionType, req.CommentText, req.ImageData)
 	if err != nil {
 		reply.StatusCode = CodeFailed
 		reply.StatusMsg = err.Error()
@@ -59,5 +70,17 @@ func (s *CommentService) CommentAction(ctx context.Context, req *pb.CommentActio
 		reply.StatusMsg = err.Error()
 		return reply, nil
 	}
+	reply.Comment.CreateDate = comment.CreateTime.Format(DateLayout)
+	return reply, nil
+}
+
+func (s *CommentService) CommentReaction(ctx context.Context, req *pb.CommentReactionRequest) (*pb.CommentReactionReply, error) {
+	reply := &pb.CommentReactionReply{StatusCode: CodeSuccess, StatusMsg: "success"}
+	err := s.cu.ReactionAction(ctx, req.CommentId, req.ActionType, req.Emoji)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
 	return reply, nil
 }
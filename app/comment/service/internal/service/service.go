@@ -9,3 +9,6 @@ const (
 	CodeSuccess = 0
 	CodeFailed  = 300
 )
+
+// DateLayout 评论发布日期的展示格式
+const DateLayout = "01-02"
@@ -0,0 +1,51 @@
+// dedup 清理favorites表中的重复喜爱关系并重新计算相关计数。
+//
+// 旧版本的favorites表只有普通索引，并发的重复请求可能插入重复的关系并重复计数，
+// 升级到带唯一索引的版本前需要先执行该命令，否则服务启动时无法创建唯一索引。
+// User服务和Publish服务中缓存的计数会在缓存过期后自动更新。
+//
+//	go run ./app/favorite/service/cmd/dedup -conf app/favorite/service/configs
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/toomanysource/atreus/app/favorite/service/internal/conf"
+	"github.com/toomanysource/atreus/app/favorite/service/internal/data"
+	"github.com/toomanysource/atreus/pkg/cmdX"
+)
+
+var flagConf string
+
+func init() {
+	flag.StringVar(&flagConf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+}
+
+func main() {
+	flag.Parse()
+	_, logs := cmdX.NewLogger("cmd/dedup")
+	var bc conf.Bootstrap
+	if err := cmdX.LoadConfig(flagConf, &bc); err != nil {
+		panic(err)
+	}
+	db, err := cmdX.OpenDB(bc.Data.Mysql.Dsn)
+	if err != nil {
+		logs.Fatalf("database connection failure, err : %v", err)
+	}
+	ctx := context.Background()
+	removed, err := data.DeduplicateFavorites(ctx, db)
+	if err != nil {
+		logs.Fatalf("deduplicate favorites failed, err : %v", err)
+	}
+	logs.Infof("removed %d duplicate favorites", removed)
+	// 去重后才能创建唯一索引
+	data.InitDB(db)
+	if err = data.DropLegacyFavoriteIndex(db); err != nil {
+		logs.Fatalf("drop legacy index failed, err : %v", err)
+	}
+	if err = data.RecomputeFavoriteCounters(ctx, db); err != nil {
+		logs.Fatalf("recompute favorite counters failed, err : %v", err)
+	}
+	logs.Info("favorite counters recomputed successfully")
+}
@@ -33,8 +33,15 @@ func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Da
 	discovery := server.NewDiscovery(registry)
 	publishServiceClient := server.NewPublishClient(discovery, logger)
 	favoriteRepo := data.NewFavoriteRepo(dataData, publishServiceClient, logger)
-	favoriteUseCase := biz.NewFavoriteUseCase(favoriteRepo, logger)
-	favoriteService := service.NewFavoriteService(favoriteUseCase, logger)
+	userServiceClient := server.NewUserClient(discovery, logger)
+	userRepo := data.NewUserRepo(userServiceClient)
+	relationServiceClient := server.NewRelationClient(discovery, logger)
+	relationRepo := data.NewRelationRepo(relationServiceClient)
+	favoriteUseCase := biz.NewFavoriteUseCase(favoriteRepo, userRepo, relationRepo, logger)
+	publishRepo := data.NewPublishRepo(publishServiceClient)
+	collectionRepo := data.NewCollectionRepo(dataData, publishRepo, logger)
+	collectionUseCase := biz.NewCollectionUseCase(collectionRepo, favoriteRepo, logger)
+	favoriteService := service.NewFavoriteService(favoriteUseCase, collectionUseCase, logger)
 	grpcServer := server.NewGRPCServer(confServer, favoriteService, logger)
 	httpServer := server.NewHTTPServer(confServer, jwt, favoriteService, logger)
 	registrar := server.NewRegistrar(registry)
@@ -7,11 +7,35 @@ import (
 )
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewFavoriteUseCase)
+var ProviderSet = wire.NewSet(NewFavoriteUseCase, NewCollectionUseCase)
 
 const (
 	Favorite   uint32 = 1
 	UnFavorite uint32 = 2
 )
 
-var ErrInValidActionType = errors.New("invalid action type")
+// 喜爱列表可见范围，与User服务的隐私设置保持一致
+const (
+	FavoritePublic    uint32 = 0
+	FavoriteFollowers uint32 = 1
+	FavoritePrivate   uint32 = 2
+)
+
+const (
+	CollectionCreate uint32 = 1
+	CollectionDelete uint32 = 2
+	CollectionUpdate uint32 = 3
+)
+
+const (
+	CollectionAdd    uint32 = 1
+	CollectionRemove uint32 = 2
+)
+
+var (
+	ErrInValidActionType    = errors.New("invalid action type")
+	ErrCollectionNameEmpty  = errors.New("collection name is empty")
+	ErrCollectionPrivate    = errors.New("collection is private")
+	ErrCollectionPermission = errors.New("no permission to operate collection")
+	ErrFavoriteListHidden   = errors.New("favorite list is hidden")
+)
@@ -0,0 +1,162 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/toomanysource/atreus/middleware"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type Collection struct {
+	Id         uint32
+	UserId     uint32
+	Name       string
+	IsPrivate  bool
+	VideoCount uint32
+}
+
+type CollectionRepo interface {
+	CreateCollection(ctx context.Context, userId uint32, name string, isPrivate bool) (*Collection, error)
+	UpdateCollection(ctx context.Context, collectionId uint32, name string, isPrivate bool) (*Collection, error)
+	DeleteCollection(ctx context.Context, collectionId uint32) error
+	GetCollection(ctx context.Context, collectionId uint32) (*Collection, error)
+	GetCollections(ctx context.Context, userId uint32, onlyPublic bool) ([]*Collection, error)
+	AddCollectionVideo(ctx context.Context, userId, collectionId, videoId uint32) error
+	RemoveCollectionVideo(ctx context.Context, collectionId, videoId uint32) error
+	GetCollectionVideos(ctx context.Context, userId, collectionId uint32) ([]Video, error)
+}
+
+type CollectionUseCase struct {
+	repo         CollectionRepo
+	favoriteRepo FavoriteRepo
+	log          *log.Helper
+}
+
+func NewCollectionUseCase(repo CollectionRepo, favoriteRepo FavoriteRepo, logger log.Logger) *CollectionUseCase {
+	return &CollectionUseCase{
+		repo:         repo,
+		favoriteRepo: favoriteRepo,
+		log:          log.NewHelper(log.With(logger, "model", "usecase/collection")),
+	}
+}
+
+// CollectionAction 创建、删除或修改收藏夹，删除时返回nil
+func (uc *CollectionUseCase) CollectionAction(
+	ctx context.Context, collectionId, actionType uint32, name string, isPrivate bool,
+) (*Collection, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	switch actionType {
+	case CollectionCreate:
+		if name == "" {
+			return nil, ErrCollectionNameEmpty
+		}
+		collection, err := uc.repo.CreateCollection(ctx, userId, name, isPrivate)
+		if err != nil {
+			uc.log.Errorf("CreateCollection error: %v", err)
+		}
+		return collection, err
+	case CollectionDelete:
+		if _, err := uc.checkOwner(ctx, userId, collectionId); err != nil {
+			return nil, err
+		}
+		err := uc.repo.DeleteCollection(ctx, collectionId)
+		if err != nil {
+			uc.log.Errorf("DeleteCollection error: %v", err)
+		}
+		return nil, err
+	case CollectionUpdate:
+		if name == "" {
+			return nil, ErrCollectionNameEmpty
+		}
+		if _, err := uc.checkOwner(ctx, userId, collectionId); err != nil {
+			return nil, err
+		}
+		collection, err := uc.repo.UpdateCollection(ctx, collectionId, name, isPrivate)
+		if err != nil {
+			uc.log.Errorf("UpdateCollection error: %v", err)
+		}
+		return collection, err
+	default:
+		return nil, ErrInValidActionType
+	}
+}
+
+// GetCollectionList 获取收藏夹列表，查询他人时只返回公开的收藏夹
+func (uc *CollectionUseCase) GetCollectionList(ctx context.Context, userId uint32) ([]*Collection, error) {
+	ownerId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	if userId == 0 {
+		userId = ownerId
+	}
+	collections, err := uc.repo.GetCollections(ctx, userId, userId != ownerId)
+	if err != nil {
+		uc.log.Errorf("GetCollectionList error: %v", err)
+	}
+	return collections, err
+}
+
+// CollectionVideoAction 向收藏夹添加或移除视频，添加时未喜爱的视频会同时被喜爱
+func (uc *CollectionUseCase) CollectionVideoAction(
+	ctx context.Context, collectionId, videoId, actionType uint32,
+) error {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	if _, err := uc.checkOwner(ctx, userId, collectionId); err != nil {
+		return err
+	}
+	switch actionType {
+	case CollectionAdd:
+		oks, err := uc.favoriteRepo.IsFavorite(ctx, userId, []uint32{videoId})
+		if err != nil {
+			uc.log.Errorf("IsFavorite error: %v", err)
+			return err
+		}
+		if !oks[0] {
+			if _, err = uc.favoriteRepo.CreateFavorite(ctx, userId, videoId); err != nil {
+				uc.log.Errorf("create favorite error: %v", err)
+				return err
+			}
+		}
+		err = uc.repo.AddCollectionVideo(ctx, userId, collectionId, videoId)
+		if err != nil {
+			uc.log.Errorf("AddCollectionVideo error: %v", err)
+		}
+		return err
+	case CollectionRemove:
+		err := uc.repo.RemoveCollectionVideo(ctx, collectionId, videoId)
+		if err != nil {
+			uc.log.Errorf("RemoveCollectionVideo error: %v", err)
+		}
+		return err
+	default:
+		return ErrInValidActionType
+	}
+}
+
+// GetCollectionVideoList 获取收藏夹中的视频，私密收藏夹只有所有者可以查看
+func (uc *CollectionUseCase) GetCollectionVideoList(ctx context.Context, collectionId uint32) ([]Video, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	collection, err := uc.repo.GetCollection(ctx, collectionId)
+	if err != nil {
+		return nil, err
+	}
+	if collection.IsPrivate && collection.UserId != userId {
+		return nil, ErrCollectionPrivate
+	}
+	videos, err := uc.repo.GetCollectionVideos(ctx, userId, collectionId)
+	if err != nil {
+		uc.log.Errorf("GetCollectionVideos error: %v", err)
+	}
+	return videos, err
+}
+
+// checkOwner 检查收藏夹是否属于当前用户
+func (uc *CollectionUseCase) checkOwner(ctx context.Context, userId, collectionId uint32) (*Collection, error) {
+	collection, err := uc.repo.GetCollection(ctx, collectionId)
+	if err != nil {
+		return nil, err
+	}
+	if collection.UserId != userId {
+		return nil, ErrCollectionPermission
+	}
+	return collection, nil
+}
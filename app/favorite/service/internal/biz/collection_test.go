@@ -0,0 +1,150 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	errNotExistCollection = errors.New("not exist collection")
+	testCollectionData    = map[uint32]*Collection{
+		1: {Id: 1, UserId: 1, Name: "public", IsPrivate: false},
+		2: {Id: 2, UserId: 1, Name: "private", IsPrivate: true},
+		3: {Id: 3, UserId: 2, Name: "other public", IsPrivate: false},
+		4: {Id: 4, UserId: 2, Name: "other private", IsPrivate: true},
+	}
+	testCollectionVideos = map[uint32][]uint32{
+		1: {1},
+		4: {2, 3},
+	}
+	collectionAutoId uint32 = 5
+)
+
+type MockCollectionRepo struct{}
+
+func (m *MockCollectionRepo) CreateCollection(
+	ctx context.Context, userId uint32, name string, isPrivate bool,
+) (*Collection, error) {
+	collection := &Collection{Id: collectionAutoId, UserId: userId, Name: name, IsPrivate: isPrivate}
+	testCollectionData[collection.Id] = collection
+	collectionAutoId++
+	return collection, nil
+}
+
+func (m *MockCollectionRepo) UpdateCollection(
+	ctx context.Context, collectionId uint32, name string, isPrivate bool,
+) (*Collection, error) {
+	collection, ok := testCollectionData[collectionId]
+	if !ok {
+		return nil, errNotExistCollection
+	}
+	collection.Name = name
+	collection.IsPrivate = isPrivate
+	return collection, nil
+}
+
+func (m *MockCollectionRepo) DeleteCollection(ctx context.Context, collectionId uint32) error {
+	delete(testCollectionData, collectionId)
+	delete(testCollectionVideos, collectionId)
+	return nil
+}
+
+func (m *MockCollectionRepo) GetCollection(ctx context.Context, collectionId uint32) (*Collection, error) {
+	collection, ok := testCollectionData[collectionId]
+	if !ok {
+		return nil, errNotExistCollection
+	}
+	return collection, nil
+}
+
+func (m *MockCollectionRepo) GetCollections(
+	ctx context.Context, userId uint32, onlyPublic bool,
+) ([]*Collection, error) {
+	var collections []*Collection
+	for _, v := range testCollectionData {
+		if v.UserId != userId || (onlyPublic && v.IsPrivate) {
+			continue
+		}
+		collections = append(collections, v)
+	}
+	return collections, nil
+}
+
+func (m *MockCollectionRepo) AddCollectionVideo(ctx context.Context, userId, collectionId, videoId uint32) error {
+	testCollectionVideos[collectionId] = append(testCollectionVideos[collectionId], videoId)
+	return nil
+}
+
+func (m *MockCollectionRepo) RemoveCollectionVideo(ctx context.Context, collectionId, videoId uint32) error {
+	videos := testCollectionVideos[collectionId]
+	for i, v := range videos {
+		if v == videoId {
+			testCollectionVideos[collectionId] = append(videos[:i], videos[i+1:]...)
+			return nil
+		}
+	}
+	return errNotExistCollection
+}
+
+func (m *MockCollectionRepo) GetCollectionVideos(ctx context.Context, userId, collectionId uint32) ([]Video, error) {
+	var videos []Video
+	for _, id := range testCollectionVideos[collectionId] {
+		videos = append(videos, Video{Id: id})
+	}
+	return videos, nil
+}
+
+func TestCollectionUsecase_CollectionAction(t *testing.T) {
+	collection, err := collectionUsecase.CollectionAction(ctx, 0, CollectionCreate, "new", false)
+	assert.Nil(t, err)
+	assert.Equal(t, "new", collection.Name)
+	_, err = collectionUsecase.CollectionAction(ctx, 0, CollectionCreate, "", false)
+	assert.ErrorIs(t, err, ErrCollectionNameEmpty)
+	collection, err = collectionUsecase.CollectionAction(ctx, collection.Id, CollectionUpdate, "renamed", true)
+	assert.Nil(t, err)
+	assert.Equal(t, "renamed", collection.Name)
+	assert.True(t, collection.IsPrivate)
+	_, err = collectionUsecase.CollectionAction(ctx, 3, CollectionUpdate, "steal", false)
+	assert.ErrorIs(t, err, ErrCollectionPermission)
+	_, err = collectionUsecase.CollectionAction(ctx, 3, CollectionDelete, "", false)
+	assert.ErrorIs(t, err, ErrCollectionPermission)
+	_, err = collectionUsecase.CollectionAction(ctx, collection.Id, CollectionDelete, "", false)
+	assert.Nil(t, err)
+	_, err = collectionUsecase.CollectionAction(ctx, 1, 4, "", false)
+	assert.ErrorIs(t, err, ErrInValidActionType)
+}
+
+func TestCollectionUsecase_GetCollectionList(t *testing.T) {
+	collections, err := collectionUsecase.GetCollectionList(ctx, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(collections))
+	collections, err = collectionUsecase.GetCollectionList(ctx, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(collections))
+	assert.False(t, collections[0].IsPrivate)
+}
+
+func TestCollectionUsecase_CollectionVideoAction(t *testing.T) {
+	err := collectionUsecase.CollectionVideoAction(ctx, 1, 2, CollectionAdd)
+	assert.Nil(t, err)
+	err = collectionUsecase.CollectionVideoAction(ctx, 1, 2, CollectionRemove)
+	assert.Nil(t, err)
+	err = collectionUsecase.CollectionVideoAction(ctx, 3, 2, CollectionAdd)
+	assert.ErrorIs(t, err, ErrCollectionPermission)
+	err = collectionUsecase.CollectionVideoAction(ctx, 1, 2, 3)
+	assert.ErrorIs(t, err, ErrInValidActionType)
+}
+
+func TestCollectionUsecase_GetCollectionVideoList(t *testing.T) {
+	videos, err := collectionUsecase.GetCollectionVideoList(ctx, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(videos))
+	videos, err = collectionUsecase.GetCollectionVideoList(ctx, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(videos))
+	_, err = collectionUsecase.GetCollectionVideoList(ctx, 4)
+	assert.ErrorIs(t, err, ErrCollectionPrivate)
+}
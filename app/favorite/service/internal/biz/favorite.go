@@ -36,51 +36,79 @@ type User struct {
 type FavoriteRepo interface {
 	GetFavoriteList(ctx context.Context, userID uint32) ([]Video, error)
 	IsFavorite(ctx context.Context, userID uint32, videoID []uint32) ([]bool, error)
-	DeleteFavorite(ctx context.Context, userID uint32, videoID uint32) error
-	CreateFavorite(ctx context.Context, userID uint32, videoID uint32) error
+	DeleteFavorite(ctx context.Context, userID uint32, videoID uint32) (bool, error)
+	CreateFavorite(ctx context.Context, userID uint32, videoID uint32) (bool, error)
 }
 
 type PublishRepo interface {
 	GetVideoListByVideoIds(ctx context.Context, userId uint32, videoIds []uint32) ([]Video, error)
 }
 
+type UserRepo interface {
+	GetFavoriteVisibility(ctx context.Context, userId uint32) (uint32, error)
+}
+
+type RelationRepo interface {
+	IsFollow(ctx context.Context, userId uint32, toUserIds []uint32) ([]bool, error)
+}
+
 type FavoriteUseCase struct {
-	repo FavoriteRepo
-	log  *log.Helper
+	repo         FavoriteRepo
+	userRepo     UserRepo
+	relationRepo RelationRepo
+	log          *log.Helper
 }
 
-func NewFavoriteUseCase(repo FavoriteRepo, logger log.Logger) *FavoriteUseCase {
-	return &FavoriteUseCase{repo: repo, log: log.NewHelper(log.With(logger, "model", "usecase/favorite"))}
+func NewFavoriteUseCase(
+	repo FavoriteRepo, userRepo UserRepo, relationRepo RelationRepo, logger log.Logger,
+) *FavoriteUseCase {
+	return &FavoriteUseCase{
+		repo:         repo,
+		userRepo:     userRepo,
+		relationRepo: relationRepo,
+		log:          log.NewHelper(log.With(logger, "model", "usecase/favorite")),
+	}
 }
 
+// FavoriteAction 喜爱或取消喜爱视频，重复操作视为成功
 func (uc *FavoriteUseCase) FavoriteAction(ctx context.Context, videoId, actionType uint32) error {
 	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
 	switch actionType {
 	case Favorite:
-		err := uc.repo.CreateFavorite(ctx, userId, videoId)
+		changed, err := uc.repo.CreateFavorite(ctx, userId, videoId)
 		if err != nil {
 			uc.log.Errorf("create favorite error: %v", err)
+			return err
 		}
-		return err
+		if !changed {
+			uc.log.Infof("favorite already exists, userId: %v - videoId: %v", userId, videoId)
+		}
+		return nil
 	case UnFavorite:
-		err := uc.repo.DeleteFavorite(ctx, userId, videoId)
+		changed, err := uc.repo.DeleteFavorite(ctx, userId, videoId)
 		if err != nil {
 			uc.log.Errorf("delete favorite error: %v", err)
+			return err
 		}
-		return err
+		if !changed {
+			uc.log.Infof("favorite not exists, userId: %v - videoId: %v", userId, videoId)
+		}
+		return nil
 	default:
 		return ErrInValidActionType
 	}
 }
 
 func (uc *FavoriteUseCase) GetFavoriteList(ctx context.Context, userID uint32) ([]Video, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
 	if userID == 0 {
-		userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
-		videos, err := uc.repo.GetFavoriteList(ctx, userId)
-		if err != nil {
-			uc.log.Errorf("GetFavoriteList error: %v", err)
+		userID = userId
+	}
+	// 查看他人的喜爱列表需要检查对方的可见范围设置
+	if userID != userId {
+		if err := uc.checkVisible(ctx, userId, userID); err != nil {
+			return nil, err
 		}
-		return videos, err
 	}
 	videos, err := uc.repo.GetFavoriteList(ctx, userID)
 	if err != nil {
@@ -89,6 +117,35 @@ func (uc *FavoriteUseCase) GetFavoriteList(ctx context.Context, userID uint32) (
 	return videos, err
 }
 
+// checkVisible 检查userId是否有权限查看ownerId的喜爱列表
+func (uc *FavoriteUseCase) checkVisible(ctx context.Context, userId, ownerId uint32) error {
+	visibility, err := uc.userRepo.GetFavoriteVisibility(ctx, ownerId)
+	if err != nil {
+		uc.log.Errorf("GetFavoriteVisibility error: %v", err)
+		return err
+	}
+	switch visibility {
+	case FavoritePublic:
+		return nil
+	case FavoriteFollowers:
+		// 未登录用户无法成为关注者
+		if userId == 0 {
+			return ErrFavoriteListHidden
+		}
+		follows, err := uc.relationRepo.IsFollow(ctx, userId, []uint32{ownerId})
+		if err != nil {
+			uc.log.Errorf("IsFollow error: %v", err)
+			return err
+		}
+		if len(follows) == 0 || !follows[0] {
+			return ErrFavoriteListHidden
+		}
+		return nil
+	default:
+		return ErrFavoriteListHidden
+	}
+}
+
 func (uc *FavoriteUseCase) IsFavorite(ctx context.Context, userID uint32, videoIDs []uint32) ([]bool, error) {
 	oks, err := uc.repo.IsFavorite(ctx, userID, videoIDs)
 	if err != nil {
@@ -47,14 +47,27 @@ var (
 	}
 )
 
+// testFavoriteRelation 已存在的喜爱关系
+var testFavoriteRelation = map[[2]uint32]bool{}
+
 type MockFavoriteRepo struct{}
 
-func (m *MockFavoriteRepo) DeleteFavorite(ctx context.Context, userId uint32, videoId uint32) error {
-	return nil
+func (m *MockFavoriteRepo) DeleteFavorite(ctx context.Context, userId uint32, videoId uint32) (bool, error) {
+	key := [2]uint32{userId, videoId}
+	if !testFavoriteRelation[key] {
+		return false, nil
+	}
+	delete(testFavoriteRelation, key)
+	return true, nil
 }
 
-func (m *MockFavoriteRepo) CreateFavorite(ctx context.Context, userId uint32, videoId uint32) error {
-	return nil
+func (m *MockFavoriteRepo) CreateFavorite(ctx context.Context, userId uint32, videoId uint32) (bool, error) {
+	key := [2]uint32{userId, videoId}
+	if testFavoriteRelation[key] {
+		return false, nil
+	}
+	testFavoriteRelation[key] = true
+	return true, nil
 }
 
 func (m *MockFavoriteRepo) GetFavoriteList(ctx context.Context, userId uint32) ([]Video, error) {
@@ -75,14 +88,46 @@ func (m *MockFavoriteRepo) IsFavorite(ctx context.Context, userId uint32, videoI
 	return isFavorite, nil
 }
 
+// testVisibilityData 用户喜爱列表的可见范围
+var testVisibilityData = map[uint32]uint32{
+	2: FavoritePublic,
+	3: FavoriteFollowers,
+	4: FavoriteFollowers,
+	5: FavoritePrivate,
+}
+
+// testFollowData 用户1关注的用户
+var testFollowData = map[uint32]bool{
+	3: true,
+}
+
+type MockUserRepo struct{}
+
+func (m *MockUserRepo) GetFavoriteVisibility(ctx context.Context, userId uint32) (uint32, error) {
+	return testVisibilityData[userId], nil
+}
+
+type MockRelationRepo struct{}
+
+func (m *MockRelationRepo) IsFollow(ctx context.Context, userId uint32, toUserIds []uint32) ([]bool, error) {
+	follows := make([]bool, 0, len(toUserIds))
+	for _, v := range toUserIds {
+		follows = append(follows, userId == 1 && testFollowData[v])
+	}
+	return follows, nil
+}
+
 var (
-	mockRepo = &MockFavoriteRepo{}
-	usecase  *FavoriteUseCase
+	mockRepo           = &MockFavoriteRepo{}
+	mockCollectionRepo = &MockCollectionRepo{}
+	usecase            *FavoriteUseCase
+	collectionUsecase  *CollectionUseCase
 )
 
 func TestMain(m *testing.M) {
 	ctx = context.WithValue(ctx, middleware.UserIdKey("user_id"), uint32(1))
-	usecase = NewFavoriteUseCase(mockRepo, log.DefaultLogger)
+	usecase = NewFavoriteUseCase(mockRepo, &MockUserRepo{}, &MockRelationRepo{}, log.DefaultLogger)
+	collectionUsecase = NewCollectionUseCase(mockCollectionRepo, mockRepo, log.DefaultLogger)
 	r := m.Run()
 	os.Exit(r)
 }
@@ -99,6 +144,19 @@ func TestFavoriteUsecase_FavoriteAction(t *testing.T) {
 	assert.NotEqual(t, err, nil)
 }
 
+func TestFavoriteUsecase_FavoriteActionIdempotent(t *testing.T) {
+	for i := 0; i < 2; i++ {
+		err := usecase.FavoriteAction(ctx, 5, Favorite)
+		assert.Nil(t, err)
+	}
+	assert.True(t, testFavoriteRelation[[2]uint32{1, 5}])
+	for i := 0; i < 2; i++ {
+		err := usecase.FavoriteAction(ctx, 5, UnFavorite)
+		assert.Nil(t, err)
+	}
+	assert.False(t, testFavoriteRelation[[2]uint32{1, 5}])
+}
+
 func TestFavoriteUsecase_GetFavoriteList(t *testing.T) {
 	favorites, err := usecase.GetFavoriteList(ctx, 1)
 	assert.Nil(t, err)
@@ -112,6 +170,23 @@ func TestFavoriteUsecase_GetFavoriteList(t *testing.T) {
 	}
 }
 
+func TestFavoriteUsecase_GetFavoriteListVisibility(t *testing.T) {
+	_, err := usecase.GetFavoriteList(ctx, 2)
+	assert.Nil(t, err)
+	_, err = usecase.GetFavoriteList(ctx, 3)
+	assert.Nil(t, err)
+	_, err = usecase.GetFavoriteList(ctx, 4)
+	assert.ErrorIs(t, err, ErrFavoriteListHidden)
+	_, err = usecase.GetFavoriteList(ctx, 5)
+	assert.ErrorIs(t, err, ErrFavoriteListHidden)
+	// 未登录用户只能查看公开的喜爱列表
+	guest := context.WithValue(context.Background(), middleware.UserIdKey("user_id"), uint32(0))
+	_, err = usecase.GetFavoriteList(guest, 2)
+	assert.Nil(t, err)
+	_, err = usecase.GetFavoriteList(guest, 3)
+	assert.ErrorIs(t, err, ErrFavoriteListHidden)
+}
+
 func TestFavoriteUsecase_IsFavorite(t *testing.T) {
 	isFavorite, err := usecase.IsFavorite(ctx, 1, []uint32{6})
 	assert.Nil(t, err)
@@ -0,0 +1,249 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/toomanysource/atreus/app/favorite/service/internal/biz"
+)
+
+type Collection struct {
+	ID         uint32 `gorm:"column:id;primary_key;autoIncrement"`
+	UserID     uint32 `gorm:"column:user_id;not null;index:idx_user_id"`
+	Name       string `gorm:"column:name;size:32;not null"`
+	IsPrivate  bool   `gorm:"column:is_private;not null;default:false"`
+	CreateTime int64  `gorm:"column:create_time;not null;default:0"`
+}
+
+func (Collection) TableName() string {
+	return "collections"
+}
+
+type CollectionVideo struct {
+	ID           uint32 `gorm:"column:id;primary_key;autoIncrement"`
+	CollectionID uint32 `gorm:"column:collection_id;not null;uniqueIndex:idx_collection_video"`
+	VideoID      uint32 `gorm:"column:video_id;not null;uniqueIndex:idx_collection_video;index:idx_user_video"`
+	UserID       uint32 `gorm:"column:user_id;not null;index:idx_user_video"`
+	CreateTime   int64  `gorm:"column:create_time;not null;default:0"`
+}
+
+func (CollectionVideo) TableName() string {
+	return "collection_videos"
+}
+
+type collectionRepo struct {
+	data        *Data
+	publishRepo biz.PublishRepo
+	log         *log.Helper
+}
+
+func NewCollectionRepo(data *Data, publishRepo biz.PublishRepo, logger log.Logger) biz.CollectionRepo {
+	return &collectionRepo{
+		data:        data,
+		publishRepo: publishRepo,
+		log:         log.NewHelper(log.With(logger, "module", "data/collection")),
+	}
+}
+
+// CreateCollection 创建收藏夹
+func (r *collectionRepo) CreateCollection(
+	ctx context.Context, userId uint32, name string, isPrivate bool,
+) (*biz.Collection, error) {
+	collection := &Collection{
+		UserID:     userId,
+		Name:       name,
+		IsPrivate:  isPrivate,
+		CreateTime: time.Now().Unix(),
+	}
+	if err := r.data.db.WithContext(ctx).Model(&Collection{}).Create(collection).Error; err != nil {
+		return nil, errors.Join(ErrMysqlInsert, err)
+	}
+	r.log.Infof("CreateCollection -> userId: %v - collectionId: %v", userId, collection.ID)
+	return &biz.Collection{
+		Id:        collection.ID,
+		UserId:    collection.UserID,
+		Name:      collection.Name,
+		IsPrivate: collection.IsPrivate,
+	}, nil
+}
+
+// UpdateCollection 修改收藏夹名称和可见性
+func (r *collectionRepo) UpdateCollection(
+	ctx context.Context, collectionId uint32, name string, isPrivate bool,
+) (*biz.Collection, error) {
+	err := r.data.db.WithContext(ctx).Model(&Collection{}).
+		Where("id = ?", collectionId).
+		Updates(map[string]interface{}{"name": name, "is_private": isPrivate}).Error
+	if err != nil {
+		return nil, errors.Join(ErrMysqlUpdate, err)
+	}
+	r.log.Infof("UpdateCollection -> collectionId: %v", collectionId)
+	return r.GetCollection(ctx, collectionId)
+}
+
+// DeleteCollection 删除收藏夹及其中的视频关系，视频本身的喜爱关系保留
+func (r *collectionRepo) DeleteCollection(ctx context.Context, collectionId uint32) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&CollectionVideo{}).
+			Where("collection_id = ?", collectionId).
+			Delete(&CollectionVideo{}).Error; err != nil {
+			return errors.Join(ErrMysqlDelete, err)
+		}
+		result := tx.Model(&Collection{}).Where("id = ?", collectionId).Delete(&Collection{})
+		if result.Error != nil {
+			return errors.Join(ErrMysqlDelete, result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotExistCollection
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	r.log.Infof("DeleteCollection -> collectionId: %v", collectionId)
+	return nil
+}
+
+// GetCollection 获取收藏夹信息
+func (r *collectionRepo) GetCollection(ctx context.Context, collectionId uint32) (*biz.Collection, error) {
+	collection := &Collection{}
+	err := r.data.db.WithContext(ctx).Model(&Collection{}).Where("id = ?", collectionId).Take(collection).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotExistCollection
+	}
+	if err != nil {
+		return nil, errors.Join(ErrMysqlQuery, err)
+	}
+	counts, err := r.CountCollectionVideos(ctx, []uint32{collectionId})
+	if err != nil {
+		return nil, err
+	}
+	return &biz.Collection{
+		Id:         collection.ID,
+		UserId:     collection.UserID,
+		Name:       collection.Name,
+		IsPrivate:  collection.IsPrivate,
+		VideoCount: counts[collection.ID],
+	}, nil
+}
+
+// GetCollections 获取用户的收藏夹列表，onlyPublic为true时过滤私密收藏夹
+func (r *collectionRepo) GetCollections(
+	ctx context.Context, userId uint32, onlyPublic bool,
+) ([]*biz.Collection, error) {
+	var collections []*Collection
+	db := r.data.db.WithContext(ctx).Model(&Collection{}).Where("user_id = ?", userId)
+	if onlyPublic {
+		db = db.Where("is_private = ?", false)
+	}
+	if err := db.Order("id").Find(&collections).Error; err != nil {
+		return nil, errors.Join(ErrMysqlQuery, err)
+	}
+	if len(collections) == 0 {
+		return nil, nil
+	}
+	collectionIds := make([]uint32, 0, len(collections))
+	for _, v := range collections {
+		collectionIds = append(collectionIds, v.ID)
+	}
+	counts, err := r.CountCollectionVideos(ctx, collectionIds)
+	if err != nil {
+		return nil, err
+	}
+	cl := make([]*biz.Collection, 0, len(collections))
+	for _, v := range collections {
+		cl = append(cl, &biz.Collection{
+			Id:         v.ID,
+			UserId:     v.UserID,
+			Name:       v.Name,
+			IsPrivate:  v.IsPrivate,
+			VideoCount: counts[v.ID],
+		})
+	}
+	return cl, nil
+}
+
+// AddCollectionVideo 向收藏夹添加视频
+func (r *collectionRepo) AddCollectionVideo(ctx context.Context, userId, collectionId, videoId uint32) error {
+	result := r.data.db.WithContext(ctx).Model(&CollectionVideo{}).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&CollectionVideo{
+			CollectionID: collectionId,
+			VideoID:      videoId,
+			UserID:       userId,
+			CreateTime:   time.Now().Unix(),
+		})
+	if result.Error != nil {
+		return errors.Join(ErrMysqlInsert, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrExistCollectionVideo
+	}
+	r.log.Infof("AddCollectionVideo -> collectionId: %v - videoId: %v", collectionId, videoId)
+	return nil
+}
+
+// RemoveCollectionVideo 从收藏夹移除视频
+func (r *collectionRepo) RemoveCollectionVideo(ctx context.Context, collectionId, videoId uint32) error {
+	result := r.data.db.WithContext(ctx).Model(&CollectionVideo{}).
+		Where("collection_id = ? AND video_id = ?", collectionId, videoId).
+		Delete(&CollectionVideo{})
+	if result.Error != nil {
+		return errors.Join(ErrMysqlDelete, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotInCollection
+	}
+	r.log.Infof("RemoveCollectionVideo -> collectionId: %v - videoId: %v", collectionId, videoId)
+	return nil
+}
+
+// GetCollectionVideos 获取收藏夹中的视频列表，按加入时间倒序排列
+func (r *collectionRepo) GetCollectionVideos(ctx context.Context, userId, collectionId uint32) ([]biz.Video, error) {
+	var videoIds []uint32
+	err := r.data.db.WithContext(ctx).Model(&CollectionVideo{}).
+		Where("collection_id = ?", collectionId).
+		Order("id DESC").Pluck("video_id", &videoIds).Error
+	if err != nil {
+		return nil, errors.Join(ErrMysqlQuery, err)
+	}
+	if len(videoIds) == 0 {
+		return nil, nil
+	}
+	videos, err := r.publishRepo.GetVideoListByVideoIds(ctx, userId, videoIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get video info by video ids: %w", err)
+	}
+	r.log.Infof(
+		"GetCollectionVideos -> collectionId: %v - videoIdList: %v", collectionId, videoIds)
+	return videos, nil
+}
+
+// CountCollectionVideos 统计收藏夹中的视频数量
+func (r *collectionRepo) CountCollectionVideos(
+	ctx context.Context, collectionIds []uint32,
+) (map[uint32]uint32, error) {
+	var rows []struct {
+		CollectionID uint32
+		Count        uint32
+	}
+	err := r.data.db.WithContext(ctx).Model(&CollectionVideo{}).
+		Select("collection_id, COUNT(*) AS count").
+		Where("collection_id IN ?", collectionIds).
+		Group("collection_id").Scan(&rows).Error
+	if err != nil {
+		return nil, errors.Join(ErrMysqlQuery, err)
+	}
+	counts := make(map[uint32]uint32, len(rows))
+	for _, v := range rows {
+		counts[v.CollectionID] = v.Count
+	}
+	return counts, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/go-redis/redis/v8"
 
 	"github.com/toomanysource/atreus/app/favorite/service/internal/conf"
+	"github.com/toomanysource/atreus/pkg/outboxX"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
@@ -18,21 +19,25 @@ import (
 	"gorm.io/gorm/logger"
 )
 
-var ProviderSet = wire.NewSet(NewData, NewKafkaWriter, NewFavoriteRepo, NewPublishRepo, NewMysqlConn, NewRedisConn)
+var ProviderSet = wire.NewSet(NewData, NewKafkaWriter, NewFavoriteRepo, NewCollectionRepo, NewPublishRepo, NewUserRepo, NewRelationRepo, NewMysqlConn, NewRedisConn)
 
 var (
-	ErrCopy                   = errors.New("copy error")
-	ErrRedisSet               = errors.New("redis set error")
-	ErrRedisQuery             = errors.New("redis query error")
-	ErrMysqlDelete            = errors.New("mysql delete error")
-	ErrMysqlInsert            = errors.New("mysql insert error")
-	ErrMysqlQuery             = errors.New("mysql query error")
-	ErrRedisDelete            = errors.New("redis delete error")
-	ErrRedisTransaction       = errors.New("redis transaction error")
-	ErrStrconvParse           = errors.New("strconv parse error")
-	ErrExistFavorite          = errors.New("exist favorite relation")
-	ErrPublishServiceResponse = errors.New("publish service response error")
-	ErrNotExistFavorite       = errors.New("not exist favorite relation")
+	ErrCopy                    = errors.New("copy error")
+	ErrRedisSet                = errors.New("redis set error")
+	ErrRedisQuery              = errors.New("redis query error")
+	ErrMysqlDelete             = errors.New("mysql delete error")
+	ErrMysqlInsert             = errors.New("mysql insert error")
+	ErrMysqlQuery              = errors.New("mysql query error")
+	ErrRedisDelete             = errors.New("redis delete error")
+	ErrRedisTransaction        = errors.New("redis transaction error")
+	ErrStrconvParse            = errors.New("strconv parse error")
+	ErrPublishServiceResponse  = errors.New("publish service response error")
+	ErrUserServiceResponse     = errors.New("user service response error")
+	ErrRelationServiceResponse = errors.New("relation service response error")
+	ErrMysqlUpdate             = errors.New("mysql update error")
+	ErrNotExistCollection      = errors.New("not exist collection")
+	ErrExistCollectionVideo    = errors.New("video already in collection")
+	ErrNotInCollection         = errors.New("video not in collection")
 )
 
 type KfkWriter struct {
@@ -50,8 +55,13 @@ type Data struct {
 
 func NewData(db *gorm.DB, cache *redis.Client, kfk KfkWriter, logger log.Logger) (*Data, func(), error) {
 	logHelper := log.NewHelper(log.With(logger, "module", "data/data"))
+	// 发件箱中的计数变更事件由relay投递到Kafka
+	relay := outboxX.NewRelay(db, logger, kfk.Favorite, kfk.Favored, kfk.videoFavorite)
+	relay.Start()
 	// 并发关闭所有数据库连接
 	cleanup := func() {
+		// 先停止投递再关闭Kafka连接
+		relay.Stop()
 		var wg sync.WaitGroup
 		wg.Add(1)
 		go func() {
@@ -156,9 +166,12 @@ func NewKafkaWriter(c *conf.Data, l log.Logger) KfkWriter {
 	}
 }
 
-// InitDB 创建User数据表，并自动迁移
+// InitDB 创建Favorite、Collection、CollectionVideo和发件箱数据表，并自动迁移
 func InitDB(db *gorm.DB) {
-	if err := db.AutoMigrate(&Favorite{}); err != nil {
+	if err := db.AutoMigrate(&Favorite{}, &Collection{}, &CollectionVideo{}); err != nil {
 		log.Fatalf("database initialization error, err : %v", err)
 	}
+	if err := outboxX.InitDB(db); err != nil {
+		log.Fatalf("outbox initialization error, err : %v", err)
+	}
 }
@@ -0,0 +1,54 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// DeduplicateFavorites 删除重复的喜爱关系，每组重复关系只保留id最小的一条，返回删除的行数
+func DeduplicateFavorites(ctx context.Context, db *gorm.DB) (int64, error) {
+	result := db.WithContext(ctx).Exec(
+		"DELETE f1 FROM favorites f1 JOIN favorites f2 " +
+			"ON f1.user_id = f2.user_id AND f1.video_id = f2.video_id AND f1.id > f2.id")
+	if result.Error != nil {
+		return 0, errors.Join(ErrMysqlDelete, result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// DropLegacyFavoriteIndex 删除被唯一索引取代的旧普通索引
+func DropLegacyFavoriteIndex(db *gorm.DB) error {
+	if !db.Migrator().HasIndex(&Favorite{}, "idx_user_video") {
+		return nil
+	}
+	return db.Migrator().DropIndex(&Favorite{}, "idx_user_video")
+}
+
+// RecomputeFavoriteCounters 根据喜爱关系重新计算视频点赞数、用户点赞数和用户获赞数
+func RecomputeFavoriteCounters(ctx context.Context, db *gorm.DB) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		stmts := []string{
+			// 视频的点赞总数
+			"UPDATE videos v LEFT JOIN " +
+				"(SELECT video_id, COUNT(*) AS cnt FROM favorites GROUP BY video_id) f " +
+				"ON v.id = f.video_id SET v.favorite_count = COALESCE(f.cnt, 0)",
+			// 用户的点赞数量
+			"UPDATE users u LEFT JOIN " +
+				"(SELECT user_id, COUNT(*) AS cnt FROM favorites GROUP BY user_id) f " +
+				"ON u.id = f.user_id SET u.favorite_count = COALESCE(f.cnt, 0)",
+			// 用户的获赞总数
+			"UPDATE users u LEFT JOIN " +
+				"(SELECT v.author_id, COUNT(*) AS cnt FROM favorites f JOIN videos v ON f.video_id = v.id " +
+				"GROUP BY v.author_id) t " +
+				"ON u.id = t.author_id SET u.total_favorited = COALESCE(t.cnt, 0)",
+		}
+		for _, stmt := range stmts {
+			if err := tx.Exec(stmt).Error; err != nil {
+				return errors.Join(ErrMysqlUpdate, err)
+			}
+		}
+		return nil
+	})
+}
@@ -11,8 +11,9 @@ import (
 	publishv1 "github.com/toomanysource/atreus/api/publish/service/v1"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
-	"github.com/toomanysource/atreus/pkg/kafkaX"
+	"github.com/toomanysource/atreus/pkg/outboxX"
 
 	"github.com/go-redis/redis/v8"
 
@@ -28,8 +29,8 @@ const (
 
 type Favorite struct {
 	ID      uint32 `gorm:"column:id;primary_key;autoIncrement"`
-	UserID  uint32 `gorm:"column:user_id;index:idx_user_video"`
-	VideoID uint32 `gorm:"column:video_id;index:idx_user_video"`
+	UserID  uint32 `gorm:"column:user_id;uniqueIndex:uk_user_video"`
+	VideoID uint32 `gorm:"column:video_id;uniqueIndex:uk_user_video"`
 }
 
 func (Favorite) TableName() string {
@@ -54,12 +55,12 @@ func NewFavoriteRepo(
 	}
 }
 
-// CreateFavorite 创建喜爱关系
-func (r *favoriteRepo) CreateFavorite(ctx context.Context, userId, videoId uint32) error {
+// CreateFavorite 创建喜爱关系，返回喜爱状态是否发生变化
+func (r *favoriteRepo) CreateFavorite(ctx context.Context, userId, videoId uint32) (bool, error) {
 	// 先在数据库中插入关系
-	err := r.InsertFavorite(ctx, userId, videoId)
-	if err != nil {
-		return err
+	changed, err := r.InsertFavorite(ctx, userId, videoId)
+	if err != nil || !changed {
+		return false, err
 	}
 	go func() {
 		ctx := context.TODO()
@@ -81,14 +82,14 @@ func (r *favoriteRepo) CreateFavorite(ctx context.Context, userId, videoId uint3
 	}()
 	r.log.Infof(
 		"CreateFavorite -> userId: %v - videoId: %v", userId, videoId)
-	return nil
+	return true, nil
 }
 
-// DeleteFavorite 删除喜爱关系
-func (r *favoriteRepo) DeleteFavorite(ctx context.Context, userId, videoId uint32) error {
-	err := r.DelFavorite(ctx, userId, videoId)
-	if err != nil {
-		return err
+// DeleteFavorite 删除喜爱关系，返回喜爱状态是否发生变化
+func (r *favoriteRepo) DeleteFavorite(ctx context.Context, userId, videoId uint32) (bool, error) {
+	changed, err := r.DelFavorite(ctx, userId, videoId)
+	if err != nil || !changed {
+		return false, err
 	}
 	go func() {
 		ctx := context.TODO()
@@ -109,7 +110,7 @@ func (r *favoriteRepo) DeleteFavorite(ctx context.Context, userId, videoId uint3
 	}()
 	r.log.Infof(
 		"DeleteFavorite -> userId: %v - videoId: %v", userId, videoId)
-	return nil
+	return true, nil
 }
 
 // GetFavoriteList 获取喜爱列表
@@ -156,31 +157,26 @@ func (r *favoriteRepo) GetFavoriteList(ctx context.Context, userID uint32) ([]bi
 	return videos, nil
 }
 
-// IsFavorite 判断是否喜爱
-func (r *favoriteRepo) IsFavorite(ctx context.Context, userId uint32, videoIds []uint32) (oks []bool, err error) {
-	ok, err := r.CheckKey(ctx, userId)
+// IsFavorite 判断是否喜爱，缓存命中时只需要一次Redis往返
+func (r *favoriteRepo) IsFavorite(ctx context.Context, userId uint32, videoIds []uint32) ([]bool, error) {
+	oks := make([]bool, len(videoIds))
+	// 未登录用户没有喜爱关系
+	if userId == 0 || len(videoIds) == 0 {
+		return oks, nil
+	}
+	favorites, hit, err := r.CheckHKeys(ctx, userId, videoIds)
 	if err != nil {
 		return nil, err
 	}
-	if ok {
-		once := make(map[uint32]bool)
-		for _, v := range videoIds {
-			if _, ok := once[v]; ok {
-				oks = append(oks, once[v])
-				continue
-			}
-			ok, err := r.CheckHKey(ctx, userId, v)
-			if err != nil {
-				return nil, err
-			}
-			once[v] = ok
-			oks = append(oks, ok)
+	if hit {
+		for i, v := range videoIds {
+			oks[i] = favorites[v]
 		}
 		return oks, nil
 	}
 	go func() {
 		ctx := context.TODO()
-		// 如果不存在则创建
+		// 如果不存在则创建，没有喜爱视频的用户只缓存占位键，作为负缓存避免重复查询数据库
 		fl, err := r.GetFavoritesByUserId(ctx, userId)
 		if err != nil {
 			r.log.Error(err)
@@ -226,6 +222,37 @@ func (r *favoriteRepo) CheckHKey(ctx context.Context, userId, videoId uint32) (b
 	return ok, nil
 }
 
+// CheckHKeys 使用一次HMGET批量检查Hash型Key缓存，同时读取占位键判断缓存是否存在。
+// 缓存存在时占位键一定存在，hit为false表示缓存未命中，需要回源数据库
+func (r *favoriteRepo) CheckHKeys(
+	ctx context.Context, userId uint32, videoIds []uint32,
+) (favorites map[uint32]bool, hit bool, err error) {
+	fields := make([]string, 0, len(videoIds)+1)
+	fields = append(fields, OccupyKey)
+	once := make(map[uint32]struct{}, len(videoIds))
+	uniqueIds := make([]uint32, 0, len(videoIds))
+	for _, v := range videoIds {
+		if _, ok := once[v]; ok {
+			continue
+		}
+		once[v] = struct{}{}
+		uniqueIds = append(uniqueIds, v)
+		fields = append(fields, strconv.Itoa(int(v)))
+	}
+	values, err := r.data.cache.HMGet(ctx, strconv.Itoa(int(userId)), fields...).Result()
+	if err != nil {
+		return nil, false, errors.Join(ErrRedisQuery, err)
+	}
+	if values[0] == nil {
+		return nil, false, nil
+	}
+	favorites = make(map[uint32]bool, len(uniqueIds))
+	for i, v := range uniqueIds {
+		favorites[v] = values[i+1] != nil
+	}
+	return favorites, true, nil
+}
+
 // InsertCache 插入缓存
 func (r *favoriteRepo) InsertCache(ctx context.Context, userId, videoId uint32) error {
 	if err := r.data.cache.HSet(
@@ -262,79 +289,76 @@ func (r *favoriteRepo) GetFavoritesCache(ctx context.Context, userID uint32) (fl
 	return fl, nil
 }
 
-// InsertFavorite 数据库插入喜爱关系
-func (r *favoriteRepo) InsertFavorite(ctx context.Context, userId, videoId uint32) error {
+// InsertFavorite 数据库插入喜爱关系，已存在时不做任何修改，返回是否真正插入了新关系。
+// 计数变更事件与喜爱关系在同一事务中写入发件箱
+func (r *favoriteRepo) InsertFavorite(ctx context.Context, userId, videoId uint32) (bool, error) {
 	authorId, err := r.GetAuthorId(ctx, userId, videoId)
 	if err != nil {
-		return err
+		return false, err
 	}
-	err = r.data.db.WithContext(ctx).
-		Where("user_id = ? AND video_id = ?", userId, videoId).
-		First(&Favorite{}).Error
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		result := r.data.db.WithContext(ctx).Create(&Favorite{
-			UserID:  userId,
-			VideoID: videoId,
-		})
+	changed := false
+	err = r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&Favorite{
+				UserID:  userId,
+				VideoID: videoId,
+			})
 		if result.Error != nil {
 			return errors.Join(ErrMysqlInsert, result.Error)
 		}
-		go func() {
-			if err = kafkaX.Update(r.kfk.Favored, strconv.Itoa(int(authorId)), "1"); err != nil {
-				r.log.Error(err)
-			}
-		}()
-		go func() {
-			if err = kafkaX.Update(r.kfk.Favorite, strconv.Itoa(int(userId)), "1"); err != nil {
-				r.log.Error(err)
-			}
-		}()
-		go func() {
-			if err = kafkaX.Update(r.kfk.videoFavorite, strconv.Itoa(int(videoId)), "1"); err != nil {
-				r.log.Error(err)
-			}
-		}()
-		return nil
-	}
+		// 重复喜爱不产生计数变化
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		changed = true
+		return r.addCountEvents(tx, userId, videoId, authorId, "1")
+	})
 	if err != nil {
-		return errors.Join(ErrMysqlQuery, err)
+		return false, err
 	}
-	return ErrExistFavorite
+	return changed, nil
 }
 
-// DelFavorite 数据库删除喜爱关系
-func (r *favoriteRepo) DelFavorite(ctx context.Context, userId, videoId uint32) error {
+// DelFavorite 数据库删除喜爱关系，返回是否真正删除了关系
+func (r *favoriteRepo) DelFavorite(ctx context.Context, userId, videoId uint32) (bool, error) {
 	authorId, err := r.GetAuthorId(ctx, userId, videoId)
 	if err != nil {
-		return err
-	}
-	err = r.data.db.WithContext(ctx).Where("user_id = ? AND video_id = ?", userId, videoId).First(&Favorite{}).Error
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return ErrNotExistFavorite
-	}
-	if err != nil {
-		return errors.Join(ErrMysqlQuery, err)
-	}
-	result := r.data.db.WithContext(ctx).Where("user_id = ? AND video_id = ?", userId, videoId).Delete(&Favorite{})
-	if result.Error != nil {
-		return errors.Join(ErrMysqlDelete, result.Error)
+		return false, err
 	}
-	go func() {
-		if err = kafkaX.Update(r.kfk.Favored, strconv.Itoa(int(authorId)), "-1"); err != nil {
-			r.log.Error(err)
+	changed := false
+	err = r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("user_id = ? AND video_id = ?", userId, videoId).Delete(&Favorite{})
+		if result.Error != nil {
+			return errors.Join(ErrMysqlDelete, result.Error)
 		}
-	}()
-	go func() {
-		if err = kafkaX.Update(r.kfk.Favorite, strconv.Itoa(int(userId)), "-1"); err != nil {
-			r.log.Error(err)
+		// 重复取消喜爱不产生计数变化
+		if result.RowsAffected == 0 {
+			return nil
 		}
-	}()
-	go func() {
-		if err = kafkaX.Update(r.kfk.videoFavorite, strconv.Itoa(int(videoId)), "-1"); err != nil {
-			r.log.Error(err)
+		changed = true
+		// 取消喜爱的视频同时从该用户的所有收藏夹中移除
+		err := tx.Model(&CollectionVideo{}).
+			Where("user_id = ? AND video_id = ?", userId, videoId).Delete(&CollectionVideo{}).Error
+		if err != nil {
+			return errors.Join(ErrMysqlDelete, err)
 		}
-	}()
-	return nil
+		return r.addCountEvents(tx, userId, videoId, authorId, "-1")
+	})
+	if err != nil {
+		return false, err
+	}
+	return changed, nil
+}
+
+// addCountEvents 在事务中写入作者获赞数、用户点赞数和视频点赞数的变更事件
+func (r *favoriteRepo) addCountEvents(tx *gorm.DB, userId, videoId, authorId uint32, value string) error {
+	if err := outboxX.Add(tx, r.kfk.Favored.Topic, strconv.Itoa(int(authorId)), value); err != nil {
+		return err
+	}
+	if err := outboxX.Add(tx, r.kfk.Favorite.Topic, strconv.Itoa(int(userId)), value); err != nil {
+		return err
+	}
+	return outboxX.Add(tx, r.kfk.videoFavorite.Topic, strconv.Itoa(int(videoId)), value)
 }
 
 // GetFavoritesByUserId 数据库获取喜爱列表
@@ -0,0 +1,82 @@
+package data
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	benchUserId    = 1 << 31 // 基准测试使用的用户id，避免与真实数据冲突
+	benchPageCount = 30      // 一页视频流的视频数量
+)
+
+// newBenchRepo 连接ATREUS_REDIS_ADDR指定的Redis，默认为本地Redis，连接失败时跳过基准测试
+func newBenchRepo(b *testing.B) *favoriteRepo {
+	addr := os.Getenv("ATREUS_REDIS_ADDR")
+	if addr == "" {
+		addr = "127.0.0.1:6379"
+	}
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		b.Skipf("redis %v is unavailable: %v", addr, err)
+	}
+	b.Cleanup(func() {
+		client.Del(context.Background(), strconv.Itoa(benchUserId))
+		client.Close()
+	})
+	return &favoriteRepo{
+		data: &Data{cache: client},
+		log:  log.NewHelper(log.DefaultLogger),
+	}
+}
+
+// BenchmarkIsFavorite 对比一页视频逐个HEXISTS与一次HMGET判断喜爱关系的开销
+func BenchmarkIsFavorite(b *testing.B) {
+	repo := newBenchRepo(b)
+	ctx := context.Background()
+	videoIds := make([]uint32, 0, benchPageCount)
+	favorites := make([]uint32, 0, benchPageCount/2)
+	for i := uint32(1); i <= benchPageCount; i++ {
+		videoIds = append(videoIds, i)
+		if i%2 == 0 {
+			favorites = append(favorites, i)
+		}
+	}
+	if err := CreateCacheByTran(ctx, repo.data.cache, favorites, benchUserId); err != nil {
+		b.Fatal(err)
+	}
+	oks, err := repo.IsFavorite(ctx, benchUserId, videoIds)
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i, ok := range oks {
+		if ok != (videoIds[i]%2 == 0) {
+			b.Fatalf("video %v favorite status mismatch", videoIds[i])
+		}
+	}
+
+	b.Run("HExists", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := repo.CheckKey(ctx, benchUserId); err != nil {
+				b.Fatal(err)
+			}
+			for _, v := range videoIds {
+				if _, err := repo.CheckHKey(ctx, benchUserId, v); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("HMGet", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := repo.IsFavorite(ctx, benchUserId, videoIds); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
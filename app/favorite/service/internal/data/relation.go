@@ -0,0 +1,28 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/toomanysource/atreus/api/relation/service/v1"
+	"github.com/toomanysource/atreus/app/favorite/service/internal/biz"
+)
+
+type relationRepo struct {
+	client pb.RelationServiceClient
+}
+
+func NewRelationRepo(conn pb.RelationServiceClient) biz.RelationRepo {
+	return &relationRepo{
+		client: conn,
+	}
+}
+
+// IsFollow 通过Relation服务判断用户是否关注了对方
+func (r *relationRepo) IsFollow(ctx context.Context, userId uint32, toUserIds []uint32) ([]bool, error) {
+	resp, err := r.client.IsFollow(ctx, &pb.IsFollowRequest{UserId: userId, ToUserId: toUserIds})
+	if err != nil {
+		return nil, errors.Join(ErrRelationServiceResponse, err)
+	}
+	return resp.IsFollow, nil
+}
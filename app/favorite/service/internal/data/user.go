@@ -0,0 +1,28 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/toomanysource/atreus/api/user/service/v1"
+	"github.com/toomanysource/atreus/app/favorite/service/internal/biz"
+)
+
+type userRepo struct {
+	client pb.UserServiceClient
+}
+
+func NewUserRepo(conn pb.UserServiceClient) biz.UserRepo {
+	return &userRepo{
+		client: conn,
+	}
+}
+
+// GetFavoriteVisibility 通过User服务获取用户喜爱列表的可见范围
+func (u *userRepo) GetFavoriteVisibility(ctx context.Context, userId uint32) (uint32, error) {
+	resp, err := u.client.GetUserSettings(ctx, &pb.UserSettingsRequest{UserId: userId})
+	if err != nil {
+		return 0, errors.Join(ErrUserServiceResponse, err)
+	}
+	return resp.GetSettings().GetFavoriteVisibility(), nil
+}
@@ -15,9 +15,12 @@ import (
 	"github.com/hashicorp/consul/api"
 
 	publishv1 "github.com/toomanysource/atreus/api/publish/service/v1"
+	relationv1 "github.com/toomanysource/atreus/api/relation/service/v1"
+	userv1 "github.com/toomanysource/atreus/api/user/service/v1"
 )
 
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewPublishClient, NewDiscovery, NewRegistrar)
+var ProviderSet = wire.NewSet(
+	NewGRPCServer, NewHTTPServer, NewPublishClient, NewUserClient, NewRelationClient, NewDiscovery, NewRegistrar)
 
 // NewPublishClient 创建一个Publish服务客户端，接收Publish服务数据
 func NewPublishClient(r registry.Discovery, logger log.Logger) publishv1.PublishServiceClient {
@@ -38,6 +41,44 @@ func NewPublishClient(r registry.Discovery, logger log.Logger) publishv1.Publish
 	return publishv1.NewPublishServiceClient(conn)
 }
 
+// NewUserClient 创建一个User服务客户端，接收User服务数据
+func NewUserClient(r registry.Discovery, logger log.Logger) userv1.UserServiceClient {
+	logs := log.NewHelper(log.With(logger, "module", "server/user"))
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint("discovery:///atreus.user.service"),
+		grpc.WithDiscovery(r),
+		grpc.WithMiddleware(
+			recovery.Recovery(),
+			logging.Client(logger),
+		),
+	)
+	if err != nil {
+		logs.Fatalf("user service connect error, %v", err)
+	}
+	logs.Info("user service connect successfully")
+	return userv1.NewUserServiceClient(conn)
+}
+
+// NewRelationClient 创建一个Relation服务客户端，接收Relation服务数据
+func NewRelationClient(r registry.Discovery, logger log.Logger) relationv1.RelationServiceClient {
+	logs := log.NewHelper(log.With(logger, "module", "server/relation"))
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint("discovery:///atreus.relation.service"),
+		grpc.WithDiscovery(r),
+		grpc.WithMiddleware(
+			recovery.Recovery(),
+			logging.Client(logger),
+		),
+	)
+	if err != nil {
+		logs.Fatalf("relation service connect error, %v", err)
+	}
+	logs.Info("relation service connect successfully")
+	return relationv1.NewRelationServiceClient(conn)
+}
+
 func NewDiscovery(conf *conf.Registry) registry.Discovery {
 	c := api.DefaultConfig()
 	c.Address = conf.Consul.Address
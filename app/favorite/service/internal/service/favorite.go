@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"github.com/jinzhu/copier"
 
@@ -15,12 +16,14 @@ import (
 type FavoriteService struct {
 	pb.UnimplementedFavoriteServiceServer
 	fu  *biz.FavoriteUseCase
+	cu  *biz.CollectionUseCase
 	log *log.Helper
 }
 
-func NewFavoriteService(fu *biz.FavoriteUseCase, logger log.Logger) *FavoriteService {
+func NewFavoriteService(fu *biz.FavoriteUseCase, cu *biz.CollectionUseCase, logger log.Logger) *FavoriteService {
 	return &FavoriteService{
 		fu:  fu,
+		cu:  cu,
 		log: log.NewHelper(log.With(logger, "model", "service/favorite")),
 	}
 }
@@ -30,6 +33,11 @@ func (s *FavoriteService) GetFavoriteList(
 ) (*pb.FavoriteListReply, error) {
 	reply := &pb.FavoriteListReply{StatusCode: CodeSuccess, StatusMsg: "success", VideoList: make([]*pb.Video, 0)}
 	videos, err := s.fu.GetFavoriteList(ctx, req.UserId)
+	if errors.Is(err, biz.ErrFavoriteListHidden) {
+		reply.StatusCode = CodeHidden
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
 	if err != nil {
 		reply.StatusCode = CodeFailed
 		reply.StatusMsg = err.Error()
@@ -70,3 +78,80 @@ func (s *FavoriteService) IsFavorite(
 		IsFavorite: isFavorite,
 	}, nil
 }
+
+func (s *FavoriteService) CollectionAction(
+	ctx context.Context, req *pb.CollectionActionRequest,
+) (*pb.CollectionActionReply, error) {
+	reply := &pb.CollectionActionReply{StatusCode: CodeSuccess, StatusMsg: "success"}
+	collection, err := s.cu.CollectionAction(ctx, req.CollectionId, req.ActionType, req.Name, req.IsPrivate)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	// 删除功能无collection值
+	if collection == nil {
+		return reply, nil
+	}
+	reply.Collection = &pb.Collection{}
+	if err = copier.Copy(reply.Collection, collection); err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	return reply, nil
+}
+
+func (s *FavoriteService) GetCollectionList(
+	ctx context.Context, req *pb.CollectionListRequest,
+) (*pb.CollectionListReply, error) {
+	reply := &pb.CollectionListReply{
+		StatusCode: CodeSuccess, StatusMsg: "success", CollectionList: make([]*pb.Collection, 0),
+	}
+	collections, err := s.cu.GetCollectionList(ctx, req.UserId)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	if err = copier.Copy(&reply.CollectionList, &collections); err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	return reply, nil
+}
+
+func (s *FavoriteService) CollectionVideoAction(
+	ctx context.Context, req *pb.CollectionVideoActionRequest,
+) (*pb.CollectionVideoActionReply, error) {
+	reply := &pb.CollectionVideoActionReply{StatusCode: CodeSuccess, StatusMsg: "success"}
+	err := s.cu.CollectionVideoAction(ctx, req.CollectionId, req.VideoId, req.ActionType)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	return reply, nil
+}
+
+func (s *FavoriteService) GetCollectionVideoList(
+	ctx context.Context, req *pb.CollectionVideoListRequest,
+) (*pb.CollectionVideoListReply, error) {
+	reply := &pb.CollectionVideoListReply{StatusCode: CodeSuccess, StatusMsg: "success", VideoList: make([]*pb.Video, 0)}
+	videos, err := s.cu.GetCollectionVideoList(ctx, req.CollectionId)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	err = copier.CopyWithOption(&reply.VideoList, &videos, copier.Option{
+		DeepCopy: true,
+	})
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	return reply, nil
+}
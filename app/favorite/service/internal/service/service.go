@@ -8,4 +8,5 @@ var ProviderSet = wire.NewSet(NewFavoriteService)
 const (
 	CodeSuccess = 0
 	CodeFailed  = 300
+	CodeHidden  = 301 // 对方设置了喜爱列表不可见
 )
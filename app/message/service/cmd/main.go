@@ -69,7 +69,7 @@ func main() {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, &rc, bc.Data, bc.Jwt, logger)
+	app, cleanup, err := wireApp(bc.Server, &rc, bc.Data, bc.Jwt, bc.Message, bc.Minio, logger)
 	if err != nil {
 		panic(err)
 	}
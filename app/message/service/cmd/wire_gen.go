@@ -22,17 +22,26 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, jwt *conf.JWT, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, jwt *conf.JWT, message *conf.Message, minio *conf.Minio, logger log.Logger) (*kratos.App, func(), error) {
 	db := data.NewMysqlConn(confData, logger)
 	kafkaConn := data.NewKafkaConn(confData, logger)
 	client := data.NewRedisConn(confData, logger)
-	dataData, cleanup, err := data.NewData(db, kafkaConn, client, logger)
+	extraConn := data.NewMinioExtraConn(minio, logger)
+	intraConn := data.NewMinioIntraConn(minio, logger)
+	minioXClient := data.NewMinioConn(minio, extraConn, intraConn, logger)
+	dataData, cleanup, err := data.NewData(db, kafkaConn, client, minioXClient, minio, logger)
 	if err != nil {
 		return nil, nil, err
 	}
-	messageRepo := data.NewMessageRepo(dataData, logger)
-	messageUseCase := biz.NewMessageUseCase(messageRepo, logger)
-	messageService := service.NewMessageService(messageUseCase, logger)
+	discovery := server.NewDiscovery(registry)
+	relationServiceClient := server.NewRelationClient(discovery, logger)
+	publishServiceClient := server.NewPublishClient(discovery, logger)
+	userServiceClient := server.NewUserClient(discovery, logger)
+	messageRepo := data.NewMessageRepo(dataData, relationServiceClient, publishServiceClient, userServiceClient, logger)
+	messageUseCase := biz.NewMessageUseCase(message, messageRepo, logger)
+	groupRepo := data.NewGroupRepo(dataData, logger)
+	groupUseCase := biz.NewGroupUseCase(groupRepo, logger)
+	messageService := service.NewMessageService(messageUseCase, groupUseCase, logger)
 	grpcServer := server.NewGRPCServer(confServer, messageService, logger)
 	httpServer := server.NewHTTPServer(confServer, jwt, messageService, logger)
 	registrar := server.NewRegistrar(registry)
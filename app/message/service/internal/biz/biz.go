@@ -2,15 +2,56 @@ package biz
 
 import (
 	"errors"
+	"time"
 
 	"github.com/google/wire"
 )
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewMessageUseCase)
+var ProviderSet = wire.NewSet(NewMessageUseCase, NewGroupUseCase)
 
 const (
 	PublishMessage = 1
+	RecallMessage  = 2
+	DeleteMessage  = 3
 )
 
-var ErrInValidActionType = errors.New("invalid action type")
+// 消息相对于当前用户的方向
+const (
+	MsgTypeReceived uint32 = 0 // 当前用户接收的消息
+	MsgTypeSent     uint32 = 1 // 当前用户发送的消息
+)
+
+// 消息内容的类型，未指定类型的消息为文字消息
+const (
+	ContentText      uint32 = 0 // 文字
+	ContentImage     uint32 = 1 // 图片
+	ContentVideo     uint32 = 2 // 分享视频
+	ContentSticker   uint32 = 3 // 表情
+	ContentEncrypted uint32 = 4 // 端到端加密，内容为base64编码的密文
+)
+
+// StickerCount 内置表情的数量，表情id从1开始
+const StickerCount = 64
+
+// DefaultRecallWindow 未配置撤回时限时，消息发送后可以撤回的时间
+const DefaultRecallWindow = 2 * time.Minute
+
+// 推送事件的类型
+const (
+	EventMessage      = "message"       // 新消息
+	EventRead         = "read"          // 已读回执
+	EventGroupMessage = "group_message" // 群消息
+	EventRecall       = "recall"        // 消息被撤回
+)
+
+var (
+	ErrInValidActionType   = errors.New("invalid action type")
+	ErrRecallWindowExpired = errors.New("message recall window expired")
+	ErrInvalidContentType  = errors.New("invalid content type")
+	ErrEmptyContent        = errors.New("message content is empty")
+	ErrPayloadMismatch     = errors.New("message payload does not match content type")
+	ErrInvalidSticker      = errors.New("invalid sticker id")
+	ErrMissingKeyId        = errors.New("encrypted message requires sender and recipient key ids")
+	ErrInvalidCiphertext   = errors.New("invalid ciphertext")
+)
@@ -0,0 +1,33 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/toomanysource/atreus/middleware"
+)
+
+// ConversationPageSize 未指定数量时每页返回的会话数量
+const ConversationPageSize uint32 = 20
+
+// Conversation 用户与对方的会话
+type Conversation struct {
+	ToUserId    uint32
+	LastMessage *Message
+	UpdateTime  int64 // 最新消息的时间
+}
+
+// ListConversations 按最新消息从新到旧分页获取当前用户的会话列表，cursor为上一页返回的游标，0表示第一页，
+// 返回下一页的游标，没有更多会话时为0
+func (uc *MessageUseCase) ListConversations(
+	ctx context.Context, cursor uint64, count uint32,
+) ([]*Conversation, uint64, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	if count == 0 {
+		count = ConversationPageSize
+	}
+	conversations, next, err := uc.repo.ListConversations(ctx, userId, cursor, int(count))
+	if err != nil {
+		uc.log.Errorf("ListConversations error: %v", err)
+	}
+	return conversations, next, err
+}
@@ -0,0 +1,49 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testConversations 用户1的会话，游标为最新消息id，按最新消息从新到旧排列
+var testConversations = []*Conversation{
+	{ToUserId: 4, LastMessage: &Message{Id: 9}, UpdateTime: 900},
+	{ToUserId: 2, LastMessage: &Message{Id: 5}, UpdateTime: 500},
+	{ToUserId: 3, LastMessage: &Message{Id: 2}, UpdateTime: 200},
+}
+
+func (m *MockMessageRepo) ListConversations(
+	ctx context.Context, userId uint32, cursor uint64, count int,
+) (cl []*Conversation, next uint64, err error) {
+	for _, v := range testConversations {
+		if cursor != 0 && v.LastMessage.Id >= cursor {
+			continue
+		}
+		cl = append(cl, v)
+		if len(cl) == count {
+			return cl, v.LastMessage.Id, nil
+		}
+	}
+	return
+}
+
+func TestMessageUsecase_ListConversations(t *testing.T) {
+	cl, next, err := useCase.ListConversations(ctx, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(cl))
+	assert.Equal(t, uint64(0), next)
+
+	cl, next, err = useCase.ListConversations(ctx, 0, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(cl))
+	assert.Equal(t, uint32(2), cl[1].ToUserId)
+	assert.Equal(t, uint64(5), next)
+
+	cl, next, err = useCase.ListConversations(ctx, next, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(cl))
+	assert.Equal(t, uint32(3), cl[0].ToUserId)
+	assert.Equal(t, uint64(0), next)
+}
@@ -0,0 +1,229 @@
+package biz
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+
+	"github.com/toomanysource/atreus/middleware"
+)
+
+// 群成员角色
+const (
+	RoleMember uint32 = 0 // 普通成员
+	RoleAdmin  uint32 = 1 // 管理员
+	RoleOwner  uint32 = 2 // 群主
+)
+
+const (
+	MaxGroupMembers      = 500 // 群成员数量上限
+	GroupMessagePageSize = 20  // 未指定数量时每页返回的群消息数量
+)
+
+var (
+	ErrNotGroupMember   = errors.New("you are not a member of the group")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrGroupFull        = errors.New("group member limit exceeded")
+	ErrRemoveYourself   = errors.New("use leave to quit the group")
+)
+
+type Group struct {
+	Id          uint32
+	Name        string
+	OwnerId     uint32
+	MemberCount uint32
+	CreateTime  int64
+}
+
+type GroupMember struct {
+	GroupId uint32
+	UserId  uint32
+	Role    uint32
+}
+
+type GroupMessage struct {
+	Id         uint64
+	GroupId    uint32
+	FromUserId uint32
+	Content    string
+	CreateTime int64
+}
+
+type GroupRepo interface {
+	CreateGroup(ctx context.Context, name string, ownerId uint32, memberIds []uint32) (*Group, error)
+	RenameGroup(ctx context.Context, groupId uint32, name string) error
+	GetGroupMember(ctx context.Context, groupId, userId uint32) (*GroupMember, error)
+	AddGroupMembers(ctx context.Context, groupId uint32, userIds []uint32, limit int) (int, error)
+	RemoveGroupMember(ctx context.Context, groupId, userId uint32) (bool, error)
+	LeaveGroup(ctx context.Context, groupId, userId uint32) error
+	PublishGroupMessage(ctx context.Context, groupId uint32, content, clientMsgId string) error
+	GetGroupMessages(ctx context.Context, groupId uint32, cursor uint64, count int) ([]*GroupMessage, uint64, error)
+}
+
+type GroupUseCase struct {
+	repo GroupRepo
+	log  *log.Helper
+}
+
+func NewGroupUseCase(repo GroupRepo, logger log.Logger) *GroupUseCase {
+	return &GroupUseCase{
+		repo: repo,
+		log:  log.NewHelper(log.With(logger, "model", "usecase/group")),
+	}
+}
+
+// CreateGroup 创建群聊，当前用户为群主，memberIds中的用户为普通成员
+func (uc *GroupUseCase) CreateGroup(ctx context.Context, name string, memberIds []uint32) (*Group, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	members := uniqueMembers(memberIds, userId)
+	if len(members)+1 > MaxGroupMembers {
+		return nil, ErrGroupFull
+	}
+	group, err := uc.repo.CreateGroup(ctx, name, userId, members)
+	if err != nil {
+		uc.log.Errorf("CreateGroup error: %v", err)
+	}
+	return group, err
+}
+
+// RenameGroup 修改群名称，只有管理员和群主可以修改
+func (uc *GroupUseCase) RenameGroup(ctx context.Context, groupId uint32, name string) error {
+	if _, err := uc.requireRole(ctx, groupId, RoleAdmin); err != nil {
+		return err
+	}
+	err := uc.repo.RenameGroup(ctx, groupId, name)
+	if err != nil {
+		uc.log.Errorf("RenameGroup error: %v", err)
+	}
+	return err
+}
+
+// AddGroupMembers 邀请用户加入群聊，只有管理员和群主可以邀请，已经在群中的用户会被忽略，
+// 其余用户加入后超过MaxGroupMembers时返回ErrGroupFull
+func (uc *GroupUseCase) AddGroupMembers(ctx context.Context, groupId uint32, userIds []uint32) error {
+	self, err := uc.requireRole(ctx, groupId, RoleAdmin)
+	if err != nil {
+		return err
+	}
+	members := uniqueMembers(userIds, self.UserId)
+	if len(members) == 0 {
+		return nil
+	}
+	added, err := uc.repo.AddGroupMembers(ctx, groupId, members, MaxGroupMembers)
+	if errors.Is(err, ErrGroupFull) {
+		return err
+	}
+	if err != nil {
+		uc.log.Errorf("AddGroupMembers error: %v", err)
+		return err
+	}
+	uc.log.Infof("AddGroupMembers -> groupId: %v - added: %v", groupId, added)
+	return nil
+}
+
+// RemoveGroupMember 将用户移出群聊，群主可以移除任何人，管理员只能移除普通成员
+func (uc *GroupUseCase) RemoveGroupMember(ctx context.Context, groupId, userId uint32) error {
+	self, err := uc.requireRole(ctx, groupId, RoleAdmin)
+	if err != nil {
+		return err
+	}
+	if userId == self.UserId {
+		return ErrRemoveYourself
+	}
+	target, err := uc.repo.GetGroupMember(ctx, groupId, userId)
+	if err != nil {
+		uc.log.Errorf("GetGroupMember error: %v", err)
+		return err
+	}
+	// 对方不在群中视为成功
+	if target == nil {
+		return nil
+	}
+	if target.Role >= self.Role {
+		return ErrPermissionDenied
+	}
+	if _, err = uc.repo.RemoveGroupMember(ctx, groupId, userId); err != nil {
+		uc.log.Errorf("RemoveGroupMember error: %v", err)
+		return err
+	}
+	return nil
+}
+
+// LeaveGroup 退出群聊，群主退出时转让给最早加入的管理员或成员，最后一人退出时解散群聊
+func (uc *GroupUseCase) LeaveGroup(ctx context.Context, groupId uint32) error {
+	self, err := uc.requireRole(ctx, groupId, RoleMember)
+	if err != nil {
+		return err
+	}
+	err = uc.repo.LeaveGroup(ctx, groupId, self.UserId)
+	if err != nil {
+		uc.log.Errorf("LeaveGroup error: %v", err)
+	}
+	return err
+}
+
+// SendGroupMessage 发送群消息，只有群成员可以发送，clientMsgId为空时由服务端生成
+func (uc *GroupUseCase) SendGroupMessage(ctx context.Context, groupId uint32, content, clientMsgId string) error {
+	if _, err := uc.requireRole(ctx, groupId, RoleMember); err != nil {
+		return err
+	}
+	if clientMsgId == "" {
+		clientMsgId = uuid.NewString()
+	}
+	err := uc.repo.PublishGroupMessage(ctx, groupId, content, clientMsgId)
+	if err != nil {
+		uc.log.Errorf("PublishGroupMessage error: %v", err)
+	}
+	return err
+}
+
+// GetGroupMessages 按从新到旧分页获取群消息，cursor为上一页返回的游标，0表示第一页，
+// 返回下一页的游标，没有更多消息时为0
+func (uc *GroupUseCase) GetGroupMessages(
+	ctx context.Context, groupId uint32, cursor uint64, count uint32,
+) ([]*GroupMessage, uint64, error) {
+	if _, err := uc.requireRole(ctx, groupId, RoleMember); err != nil {
+		return nil, 0, err
+	}
+	if count == 0 {
+		count = GroupMessagePageSize
+	}
+	messages, next, err := uc.repo.GetGroupMessages(ctx, groupId, cursor, int(count))
+	if err != nil {
+		uc.log.Errorf("GetGroupMessages error: %v", err)
+	}
+	return messages, next, err
+}
+
+// requireRole 检查当前用户是群成员且角色不低于role，返回当前用户的成员信息
+func (uc *GroupUseCase) requireRole(ctx context.Context, groupId uint32, role uint32) (*GroupMember, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	member, err := uc.repo.GetGroupMember(ctx, groupId, userId)
+	if err != nil {
+		uc.log.Errorf("GetGroupMember error: %v", err)
+		return nil, err
+	}
+	if member == nil {
+		return nil, ErrNotGroupMember
+	}
+	if member.Role < role {
+		return nil, ErrPermissionDenied
+	}
+	return member, nil
+}
+
+// uniqueMembers 去重并排除当前用户和无效id
+func uniqueMembers(userIds []uint32, self uint32) []uint32 {
+	seen := make(map[uint32]bool, len(userIds))
+	members := make([]uint32, 0, len(userIds))
+	for _, id := range userIds {
+		if id == 0 || id == self || seen[id] {
+			continue
+		}
+		seen[id] = true
+		members = append(members, id)
+	}
+	return members
+}
@@ -0,0 +1,148 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/toomanysource/atreus/middleware"
+)
+
+// MockGroupRepo 群1的群主为用户1，用户2为管理员，用户3为普通成员
+type MockGroupRepo struct {
+	members map[uint32]uint32
+	sent    []string
+}
+
+var groupUseCase = NewGroupUseCase(newMockGroupRepo(), log.DefaultLogger)
+
+func newMockGroupRepo() *MockGroupRepo {
+	return &MockGroupRepo{members: map[uint32]uint32{1: RoleOwner, 2: RoleAdmin, 3: RoleMember}}
+}
+
+func (m *MockGroupRepo) CreateGroup(
+	ctx context.Context, name string, ownerId uint32, memberIds []uint32,
+) (*Group, error) {
+	return &Group{Id: 2, Name: name, OwnerId: ownerId, MemberCount: uint32(len(memberIds) + 1)}, nil
+}
+
+func (m *MockGroupRepo) RenameGroup(ctx context.Context, groupId uint32, name string) error {
+	return nil
+}
+
+func (m *MockGroupRepo) GetGroupMember(ctx context.Context, groupId, userId uint32) (*GroupMember, error) {
+	role, ok := m.members[userId]
+	if groupId != 1 || !ok {
+		return nil, nil
+	}
+	return &GroupMember{GroupId: groupId, UserId: userId, Role: role}, nil
+}
+
+func (m *MockGroupRepo) AddGroupMembers(
+	ctx context.Context, groupId uint32, userIds []uint32, limit int,
+) (int, error) {
+	added := make([]uint32, 0, len(userIds))
+	for _, id := range userIds {
+		if _, ok := m.members[id]; !ok {
+			added = append(added, id)
+		}
+	}
+	if len(m.members)+len(added) > limit {
+		return 0, ErrGroupFull
+	}
+	for _, id := range added {
+		m.members[id] = RoleMember
+	}
+	return len(added), nil
+}
+
+func (m *MockGroupRepo) RemoveGroupMember(ctx context.Context, groupId, userId uint32) (bool, error) {
+	delete(m.members, userId)
+	return true, nil
+}
+
+func (m *MockGroupRepo) LeaveGroup(ctx context.Context, groupId, userId uint32) error {
+	delete(m.members, userId)
+	return nil
+}
+
+func (m *MockGroupRepo) PublishGroupMessage(ctx context.Context, groupId uint32, content, clientMsgId string) error {
+	m.sent = append(m.sent, content)
+	return nil
+}
+
+func (m *MockGroupRepo) GetGroupMessages(
+	ctx context.Context, groupId uint32, cursor uint64, count int,
+) ([]*GroupMessage, uint64, error) {
+	return []*GroupMessage{{Id: 1, GroupId: groupId}}, 0, nil
+}
+
+// asUser 返回以userId身份请求的上下文
+func asUser(userId uint32) context.Context {
+	return context.WithValue(context.Background(), middleware.UserIdKey("user_id"), userId)
+}
+
+func TestGroupUsecase_CreateGroup(t *testing.T) {
+	group, err := groupUseCase.CreateGroup(asUser(1), "group", []uint32{1, 2, 2, 0, 3})
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), group.OwnerId)
+	assert.Equal(t, uint32(3), group.MemberCount)
+
+	ids := make([]uint32, MaxGroupMembers)
+	for i := range ids {
+		ids[i] = uint32(i + 2)
+	}
+	_, err = groupUseCase.CreateGroup(asUser(1), "group", ids)
+	assert.ErrorIs(t, err, ErrGroupFull)
+}
+
+func TestGroupUsecase_Permissions(t *testing.T) {
+	uc := NewGroupUseCase(newMockGroupRepo(), log.DefaultLogger)
+	// 普通成员不能改名和邀请
+	assert.ErrorIs(t, uc.RenameGroup(asUser(3), 1, "new"), ErrPermissionDenied)
+	assert.ErrorIs(t, uc.AddGroupMembers(asUser(3), 1, []uint32{4}), ErrPermissionDenied)
+	// 非成员不能发消息和查看历史
+	assert.ErrorIs(t, uc.SendGroupMessage(asUser(9), 1, "hi", ""), ErrNotGroupMember)
+	_, _, err := uc.GetGroupMessages(asUser(9), 1, 0, 0)
+	assert.ErrorIs(t, err, ErrNotGroupMember)
+
+	assert.Nil(t, uc.RenameGroup(asUser(2), 1, "new"))
+	assert.Nil(t, uc.AddGroupMembers(asUser(2), 1, []uint32{4}))
+	assert.Nil(t, uc.SendGroupMessage(asUser(4), 1, "hi", ""))
+}
+
+func TestGroupUsecase_AddGroupMembers(t *testing.T) {
+	repo := newMockGroupRepo()
+	uc := NewGroupUseCase(repo, log.DefaultLogger)
+	for i := len(repo.members); i < MaxGroupMembers; i++ {
+		repo.members[uint32(i+10)] = RoleMember
+	}
+	// 群已满时已在群中的用户不计入上限
+	assert.Nil(t, uc.AddGroupMembers(asUser(1), 1, []uint32{3}))
+	assert.ErrorIs(t, uc.AddGroupMembers(asUser(1), 1, []uint32{3, 4}), ErrGroupFull)
+	_, ok := repo.members[4]
+	assert.False(t, ok)
+}
+
+func TestGroupUsecase_RemoveGroupMember(t *testing.T) {
+	repo := newMockGroupRepo()
+	uc := NewGroupUseCase(repo, log.DefaultLogger)
+	// 管理员不能移除群主，也不能移除自己
+	assert.ErrorIs(t, uc.RemoveGroupMember(asUser(2), 1, 1), ErrPermissionDenied)
+	assert.ErrorIs(t, uc.RemoveGroupMember(asUser(2), 1, 2), ErrRemoveYourself)
+	assert.Nil(t, uc.RemoveGroupMember(asUser(2), 1, 3))
+	_, ok := repo.members[3]
+	assert.False(t, ok)
+	// 群主可以移除管理员
+	assert.Nil(t, uc.RemoveGroupMember(asUser(1), 1, 2))
+	assert.Equal(t, 1, len(repo.members))
+}
+
+func TestGroupUsecase_LeaveGroup(t *testing.T) {
+	repo := newMockGroupRepo()
+	uc := NewGroupUseCase(repo, log.DefaultLogger)
+	assert.Nil(t, uc.LeaveGroup(asUser(3), 1))
+	assert.ErrorIs(t, uc.LeaveGroup(asUser(3), 1), ErrNotGroupMember)
+}
@@ -2,8 +2,14 @@ package biz
 
 import (
 	"context"
+	"encoding/base64"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+
+	"github.com/toomanysource/atreus/app/message/service/internal/conf"
+	"github.com/toomanysource/atreus/middleware"
 )
 
 type Message struct {
@@ -12,47 +18,290 @@ type Message struct {
 	FromUserId uint32
 	Content    string
 	CreateTime int64
+	Recalled   bool
+	// 非文字消息的内容，ImageKey为图片在对象存储中的文件名，由data层转换为ImageUrl
+	ContentType uint32
+	ImageKey    string
+	ImageUrl    string `json:"-"`
+	VideoId     uint32
+	Video       *Video `json:"-"`
+	StickerId   uint32
+	ClientMsgId string
+	// 加密消息使用的双方公钥id
+	SenderKeyId    uint32
+	RecipientKeyId uint32
+}
+
+// Video 消息中分享的视频
+type Video struct {
+	Id       uint32
+	AuthorId uint32
+	Title    string
+	PlayUrl  string
+	CoverUrl string
+}
+
+// Payload 发送消息的内容，只有ContentType对应的字段可以不为空
+type Payload struct {
+	ContentType uint32
+	Content     string
+	ImageData   []byte
+	VideoId     uint32
+	StickerId   uint32
+	ClientMsgId string
+	// 加密消息使用的双方公钥id，Content为密文
+	SenderKeyId    uint32
+	RecipientKeyId uint32
+}
+
+// Validate 按消息类型校验消息内容
+func (p *Payload) Validate() error {
+	var (
+		empty    bool
+		mismatch bool
+	)
+	// 只有加密消息使用公钥id
+	keyed := p.SenderKeyId != 0 || p.RecipientKeyId != 0
+	switch p.ContentType {
+	case ContentText:
+		empty = p.Content == ""
+		mismatch = len(p.ImageData) != 0 || p.VideoId != 0 || p.StickerId != 0 || keyed
+	case ContentImage:
+		empty = len(p.ImageData) == 0
+		mismatch = p.Content != "" || p.VideoId != 0 || p.StickerId != 0 || keyed
+	case ContentVideo:
+		empty = p.VideoId == 0
+		mismatch = p.Content != "" || len(p.ImageData) != 0 || p.StickerId != 0 || keyed
+	case ContentSticker:
+		empty = p.StickerId == 0
+		mismatch = p.Content != "" || len(p.ImageData) != 0 || p.VideoId != 0 || keyed
+	case ContentEncrypted:
+		empty = p.Content == ""
+		mismatch = len(p.ImageData) != 0 || p.VideoId != 0 || p.StickerId != 0
+	default:
+		return ErrInvalidContentType
+	}
+	if empty {
+		return ErrEmptyContent
+	}
+	if mismatch {
+		return ErrPayloadMismatch
+	}
+	switch p.ContentType {
+	case ContentSticker:
+		if p.StickerId > StickerCount {
+			return ErrInvalidSticker
+		}
+	case ContentEncrypted:
+		if p.SenderKeyId == 0 || p.RecipientKeyId == 0 {
+			return ErrMissingKeyId
+		}
+		if _, err := base64.StdEncoding.DecodeString(p.Content); err != nil {
+			return ErrInvalidCiphertext
+		}
+	}
+	return nil
+}
+
+// LatestMessage 与对方用户的最新消息和未读消息数量
+type LatestMessage struct {
+	ToUserId    uint32
+	Message     *Message // 没有聊天记录时为nil
+	MsgType     uint32
+	UnreadCount uint32
+}
+
+// ReadReceipt 已读回执，UserId已读到ToUserId发送的LastReadId及之前的消息
+type ReadReceipt struct {
+	UserId     uint32
+	ToUserId   uint32
+	LastReadId uint64
+}
+
+// Event 推送给在线用户的事件，Message、Receipt和GroupMessage只有一个不为nil
+type Event struct {
+	Message      *Message
+	Receipt      *ReadReceipt
+	GroupMessage *GroupMessage
+	Recipients   []uint32 // 群消息的接收者，即发送时的全部群成员
+}
+
+// Type 事件类型
+func (e *Event) Type() string {
+	switch {
+	case e.Receipt != nil:
+		return EventRead
+	case e.GroupMessage != nil:
+		return EventGroupMessage
+	case e.Message != nil && e.Message.Recalled:
+		return EventRecall
+	default:
+		return EventMessage
+	}
+}
+
+// UnreadCount 与对方的会话中对方发送的未读消息数量
+type UnreadCount struct {
+	ToUserId    uint32
+	UnreadCount uint32
 }
 
 type MessageRepo interface {
 	GetMessageList(context.Context, uint32, int64) ([]*Message, error)
-	PublishMessage(context.Context, uint32, string) error
+	PublishMessage(ctx context.Context, toUserId uint32, payload *Payload) error
+	RecallMessage(ctx context.Context, messageId uint64, since time.Time) error
+	DeleteMessage(ctx context.Context, messageId uint64) error
+	MarkRead(ctx context.Context, toUserId uint32, messageId uint64) error
+	GetReadCursor(ctx context.Context, userId, toUserId uint32) (uint64, error)
+	GetUnreadCounts(context.Context, uint32) ([]*UnreadCount, error)
+	GetLatestMessages(ctx context.Context, userId uint32, toUserIds []uint32) ([]*LatestMessage, error)
+	SubscribeEvent(ctx context.Context, handler func(*Event)) error
+	AttachContents(ctx context.Context, userId uint32, ml []*Message) error
+	ListConversations(ctx context.Context, userId uint32, cursor uint64, count int) ([]*Conversation, uint64, error)
 	InitStoreMessageQueue()
 }
 
 type MessageUseCase struct {
-	repo MessageRepo
-	log  *log.Helper
+	repo         MessageRepo
+	recallWindow time.Duration
+	log          *log.Helper
 }
 
-func NewMessageUseCase(repo MessageRepo, logger log.Logger) *MessageUseCase {
+func NewMessageUseCase(c *conf.Message, repo MessageRepo, logger log.Logger) *MessageUseCase {
 	go repo.InitStoreMessageQueue()
+	recallWindow := c.GetRecallWindow().AsDuration()
+	if recallWindow <= 0 {
+		recallWindow = DefaultRecallWindow
+	}
 	return &MessageUseCase{
-		repo: repo,
-		log:  log.NewHelper(log.With(logger, "model", "usecase/message")),
+		repo:         repo,
+		recallWindow: recallWindow,
+		log:          log.NewHelper(log.With(logger, "model", "usecase/message")),
 	}
 }
 
+// GetMessageList 获取聊天记录和对方已读到的消息id，获取到的对方消息视为已读
 func (uc *MessageUseCase) GetMessageList(
 	ctx context.Context, toUserId uint32, preMsgTime int64,
-) ([]*Message, error) {
+) ([]*Message, uint64, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
 	messages, err := uc.repo.GetMessageList(ctx, toUserId, preMsgTime)
 	if err != nil {
 		uc.log.Errorf("GetMessageList error: %v", err)
+		return nil, 0, err
+	}
+	// 标记失败不影响获取结果
+	if lastId := lastReceivedId(messages, toUserId); lastId > 0 {
+		if err = uc.repo.MarkRead(ctx, toUserId, lastId); err != nil {
+			uc.log.Errorf("MarkRead error: %v", err)
+		}
+	}
+	peerLastReadId, err := uc.repo.GetReadCursor(ctx, toUserId, userId)
+	if err != nil {
+		uc.log.Errorf("GetReadCursor error: %v", err)
 	}
-	return messages, err
+	return messages, peerLastReadId, nil
 }
 
-func (uc *MessageUseCase) PublishMessage(
-	ctx context.Context, toUserId uint32, actionType uint32, content string,
+// lastReceivedId 返回messages中fromUserId发送的最大消息id
+func lastReceivedId(messages []*Message, fromUserId uint32) uint64 {
+	var lastId uint64
+	for _, m := range messages {
+		if m.FromUserId == fromUserId && m.Id > lastId {
+			lastId = m.Id
+		}
+	}
+	return lastId
+}
+
+// MarkRead 将与toUserId的会话标记为已读到messageId，messageId为0时已读到最新消息
+func (uc *MessageUseCase) MarkRead(ctx context.Context, toUserId uint32, messageId uint64) error {
+	err := uc.repo.MarkRead(ctx, toUserId, messageId)
+	if err != nil {
+		uc.log.Errorf("MarkRead error: %v", err)
+	}
+	return err
+}
+
+// GetUnreadCount 获取当前用户每个会话的未读消息数量和未读消息总数
+func (uc *MessageUseCase) GetUnreadCount(ctx context.Context) ([]*UnreadCount, uint32, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	counts, err := uc.repo.GetUnreadCounts(ctx, userId)
+	if err != nil {
+		uc.log.Errorf("GetUnreadCounts error: %v", err)
+		return nil, 0, err
+	}
+	var total uint32
+	for _, c := range counts {
+		total += c.UnreadCount
+	}
+	return counts, total, nil
+}
+
+// GetLatestMessages 获取用户与toUserIds中每个用户的最新消息、消息方向和未读消息数量
+func (uc *MessageUseCase) GetLatestMessages(
+	ctx context.Context, userId uint32, toUserIds []uint32,
+) ([]*LatestMessage, error) {
+	latest, err := uc.repo.GetLatestMessages(ctx, userId, toUserIds)
+	if err != nil {
+		uc.log.Errorf("GetLatestMessages error: %v", err)
+		return nil, err
+	}
+	for _, v := range latest {
+		if v.Message != nil && v.Message.FromUserId == userId {
+			v.MsgType = MsgTypeSent
+		}
+	}
+	return latest, nil
+}
+
+// SubscribeEvent 订阅所有实例产生的新消息和已读回执，阻塞直到ctx结束
+func (uc *MessageUseCase) SubscribeEvent(ctx context.Context, handler func(*Event)) error {
+	err := uc.repo.SubscribeEvent(ctx, handler)
+	if err != nil {
+		uc.log.Errorf("SubscribeEvent error: %v", err)
+	}
+	return err
+}
+
+// AttachContents 补全推送的新消息的图片地址和分享的视频，补全失败时消息仍然推送
+func (uc *MessageUseCase) AttachContents(ctx context.Context, m *Message) {
+	if err := uc.repo.AttachContents(ctx, m.ToUserId, []*Message{m}); err != nil {
+		uc.log.Errorf("AttachContents error: %v", err)
+	}
+}
+
+// MessageAction 发送、撤回和删除消息，发送时使用payload，撤回和删除时使用messageId，不使用toUserId
+func (uc *MessageUseCase) MessageAction(
+	ctx context.Context, toUserId uint32, actionType uint32, messageId uint64, payload *Payload,
 ) error {
 	switch actionType {
 	case PublishMessage:
-		err := uc.repo.PublishMessage(ctx, toUserId, content)
+		if err := payload.Validate(); err != nil {
+			return err
+		}
+		// 客户端没有提供消息id时无法去重重试，由服务端生成
+		if payload.ClientMsgId == "" {
+			payload.ClientMsgId = uuid.NewString()
+		}
+		err := uc.repo.PublishMessage(ctx, toUserId, payload)
 		if err != nil {
 			uc.log.Errorf("PublishMessage error: %v", err)
 		}
 		return err
+	case RecallMessage:
+		// 只允许撤回在撤回窗口内发送的消息
+		err := uc.repo.RecallMessage(ctx, messageId, time.Now().Add(-uc.recallWindow))
+		if err != nil {
+			uc.log.Errorf("RecallMessage error: %v", err)
+		}
+		return err
+	case DeleteMessage:
+		err := uc.repo.DeleteMessage(ctx, messageId)
+		if err != nil {
+			uc.log.Errorf("DeleteMessage error: %v", err)
+		}
+		return err
 	default:
 		return ErrInValidActionType
 	}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
 
+	"github.com/toomanysource/atreus/app/message/service/internal/conf"
 	"github.com/toomanysource/atreus/middleware"
 )
 
@@ -16,39 +19,209 @@ type MockMessageRepo struct{}
 func (m *MockMessageRepo) GetMessageList(ctx context.Context, toUserId uint32, preMsgTime int64) ([]*Message, error) {
 	return []*Message{
 		{
-			Id: 1,
+			Id:         1,
+			FromUserId: toUserId,
 		},
+		{
+			Id:         2,
+			FromUserId: 1,
+			ToUserId:   toUserId,
+		},
+	}, nil
+}
+
+func (m *MockMessageRepo) PublishMessage(ctx context.Context, toUserId uint32, payload *Payload) error {
+	return nil
+}
+
+func (m *MockMessageRepo) RecallMessage(ctx context.Context, messageId uint64, since time.Time) error {
+	recallSince = since
+	return nil
+}
+
+func (m *MockMessageRepo) DeleteMessage(ctx context.Context, messageId uint64) error {
+	return nil
+}
+
+func (m *MockMessageRepo) MarkRead(ctx context.Context, toUserId uint32, messageId uint64) error {
+	lastReadIds[toUserId] = messageId
+	return nil
+}
+
+func (m *MockMessageRepo) GetReadCursor(ctx context.Context, userId, toUserId uint32) (uint64, error) {
+	// 对方总是已读到第一条消息
+	return 1, nil
+}
+
+func (m *MockMessageRepo) GetUnreadCounts(ctx context.Context, userId uint32) ([]*UnreadCount, error) {
+	return []*UnreadCount{
+		{ToUserId: 2, UnreadCount: 3},
+		{ToUserId: 3, UnreadCount: 1},
 	}, nil
 }
 
-func (m *MockMessageRepo) PublishMessage(ctx context.Context, toUserId uint32, content string) error {
+func (m *MockMessageRepo) GetLatestMessages(
+	ctx context.Context, userId uint32, toUserIds []uint32,
+) ([]*LatestMessage, error) {
+	latest := make([]*LatestMessage, 0, len(toUserIds))
+	for _, id := range toUserIds {
+		v := &LatestMessage{ToUserId: id}
+		switch id {
+		case 2:
+			v.Message = &Message{Id: 1, FromUserId: userId, ToUserId: id}
+		case 3:
+			v.Message = &Message{Id: 2, FromUserId: id, ToUserId: userId}
+			v.UnreadCount = 1
+		}
+		latest = append(latest, v)
+	}
+	return latest, nil
+}
+
+func (m *MockMessageRepo) SubscribeEvent(ctx context.Context, handler func(*Event)) error {
+	handler(&Event{Message: &Message{Id: 1, FromUserId: 1, ToUserId: 2, Content: "hello"}})
+	handler(&Event{Receipt: &ReadReceipt{UserId: 2, ToUserId: 1, LastReadId: 1}})
+	return nil
+}
+
+func (m *MockMessageRepo) AttachContents(ctx context.Context, userId uint32, ml []*Message) error {
+	for _, v := range ml {
+		if v.ImageKey != "" {
+			v.ImageUrl = "http://oss/" + v.ImageKey
+		}
+	}
 	return nil
 }
 
 func (m *MockMessageRepo) InitStoreMessageQueue() {}
 
 var (
-	ctx      = context.Background()
-	mockRepo *MockMessageRepo
-	useCase  *MessageUseCase
+	ctx         = context.Background()
+	mockRepo    *MockMessageRepo
+	useCase     *MessageUseCase
+	lastReadIds = make(map[uint32]uint64)
+	recallSince time.Time
 )
 
 func TestMain(m *testing.M) {
 	ctx = context.WithValue(ctx, middleware.UserIdKey("user_id"), uint32(1))
-	useCase = NewMessageUseCase(mockRepo, log.DefaultLogger)
+	useCase = NewMessageUseCase(
+		&conf.Message{RecallWindow: durationpb.New(5 * time.Minute)}, mockRepo, log.DefaultLogger)
 	m.Run()
 	os.Exit(0)
 }
 
 func TestMessageUsecase_GetMessageList(t *testing.T) {
-	msgs, err := useCase.GetMessageList(ctx, 1, 0)
+	msgs, peerLastReadId, err := useCase.GetMessageList(ctx, 2, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(msgs))
+	assert.Equal(t, uint64(1), peerLastReadId)
+	// 只标记已读到对方发送的最新消息
+	assert.Equal(t, uint64(1), lastReadIds[2])
+}
+
+func TestMessageUsecase_MarkRead(t *testing.T) {
+	err := useCase.MarkRead(ctx, 3, 0)
+	assert.Nil(t, err)
+	lastReadId, ok := lastReadIds[3]
+	assert.True(t, ok)
+	assert.Equal(t, uint64(0), lastReadId)
+}
+
+func TestMessageUsecase_GetUnreadCount(t *testing.T) {
+	counts, total, err := useCase.GetUnreadCount(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(counts))
+	assert.Equal(t, uint32(4), total)
+}
+
+func TestMessageUsecase_GetLatestMessages(t *testing.T) {
+	latest, err := useCase.GetLatestMessages(ctx, 1, []uint32{2, 3, 4})
 	assert.Nil(t, err)
-	assert.Equal(t, 1, len(msgs))
+	assert.Equal(t, 3, len(latest))
+	assert.Equal(t, MsgTypeSent, latest[0].MsgType)
+	assert.Equal(t, MsgTypeReceived, latest[1].MsgType)
+	assert.Equal(t, uint32(1), latest[1].UnreadCount)
+	assert.Nil(t, latest[2].Message)
 }
 
-func TestMessageUsecase_PublishMessage(t *testing.T) {
-	err := useCase.PublishMessage(ctx, 1, 1, "hahah")
+func TestMessageUsecase_MessageAction(t *testing.T) {
+	payload := &Payload{Content: "hahah"}
+	err := useCase.MessageAction(ctx, 1, PublishMessage, 0, payload)
 	assert.Nil(t, err)
-	err = useCase.PublishMessage(ctx, 1, 0, "hahah")
+	// 没有客户端消息id时由服务端生成
+	assert.NotEmpty(t, payload.ClientMsgId)
+	payload = &Payload{Content: "hahah", ClientMsgId: "client-1"}
+	err = useCase.MessageAction(ctx, 1, PublishMessage, 0, payload)
+	assert.Nil(t, err)
+	assert.Equal(t, "client-1", payload.ClientMsgId)
+	err = useCase.MessageAction(ctx, 1, 0, 0, &Payload{Content: "hahah"})
 	assert.NotNil(t, err)
+	err = useCase.MessageAction(ctx, 1, PublishMessage, 0, &Payload{ContentType: ContentSticker})
+	assert.ErrorIs(t, err, ErrEmptyContent)
+	err = useCase.MessageAction(ctx, 0, DeleteMessage, 1, nil)
+	assert.Nil(t, err)
+}
+
+func TestMessageUsecase_RecallWindow(t *testing.T) {
+	tests := []struct {
+		conf   *conf.Message
+		window time.Duration
+	}{
+		{&conf.Message{RecallWindow: durationpb.New(5 * time.Minute)}, 5 * time.Minute},
+		{&conf.Message{}, DefaultRecallWindow},
+		{nil, DefaultRecallWindow},
+	}
+	for _, tt := range tests {
+		uc := NewMessageUseCase(tt.conf, mockRepo, log.DefaultLogger)
+		before := time.Now()
+		err := uc.MessageAction(ctx, 0, RecallMessage, 1, nil)
+		assert.Nil(t, err)
+		// 只能撤回窗口内发送的消息
+		assert.WithinRange(t, recallSince, before.Add(-tt.window), time.Now().Add(-tt.window))
+	}
+}
+
+func TestPayload_Validate(t *testing.T) {
+	tests := []struct {
+		payload *Payload
+		err     error
+	}{
+		{&Payload{Content: "hello"}, nil},
+		{&Payload{}, ErrEmptyContent},
+		{&Payload{Content: "hello", StickerId: 1}, ErrPayloadMismatch},
+		{&Payload{ContentType: ContentImage, ImageData: []byte("image")}, nil},
+		{&Payload{ContentType: ContentImage, ImageData: []byte("image"), Content: "hello"}, ErrPayloadMismatch},
+		{&Payload{ContentType: ContentVideo, VideoId: 1}, nil},
+		{&Payload{ContentType: ContentVideo}, ErrEmptyContent},
+		{&Payload{ContentType: ContentSticker, StickerId: StickerCount}, nil},
+		{&Payload{ContentType: ContentSticker, StickerId: StickerCount + 1}, ErrInvalidSticker},
+		{&Payload{ContentType: ContentEncrypted, Content: "aGVsbG8=", SenderKeyId: 1, RecipientKeyId: 2}, nil},
+		{&Payload{ContentType: ContentEncrypted, Content: "aGVsbG8=", SenderKeyId: 1}, ErrMissingKeyId},
+		{&Payload{ContentType: ContentEncrypted, Content: "hello!", SenderKeyId: 1, RecipientKeyId: 2}, ErrInvalidCiphertext},
+		{&Payload{Content: "hello", SenderKeyId: 1, RecipientKeyId: 2}, ErrPayloadMismatch},
+		{&Payload{ContentType: 5, Content: "hello"}, ErrInvalidContentType},
+	}
+	for _, tt := range tests {
+		assert.ErrorIs(t, tt.payload.Validate(), tt.err)
+	}
+}
+
+func TestMessageUsecase_SubscribeEvent(t *testing.T) {
+	var received []*Event
+	err := useCase.SubscribeEvent(ctx, func(e *Event) {
+		received = append(received, e)
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(received))
+	assert.Equal(t, EventMessage, received[0].Type())
+	assert.Equal(t, "hello", received[0].Message.Content)
+	assert.Equal(t, EventRead, received[1].Type())
+	assert.Equal(t, EventRecall, (&Event{Message: &Message{Recalled: true}}).Type())
+}
+
+func TestMessageUsecase_AttachContents(t *testing.T) {
+	m := &Message{Id: 1, FromUserId: 1, ToUserId: 2, ContentType: ContentImage, ImageKey: "a.png"}
+	useCase.AttachContents(ctx, m)
+	assert.Equal(t, "http://oss/a.png", m.ImageUrl)
 }
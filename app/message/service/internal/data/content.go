@@ -0,0 +1,109 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+
+	"github.com/toomanysource/atreus/app/message/service/internal/biz"
+)
+
+// imageUrlExpiry 图片消息地址的有效期
+const imageUrlExpiry = 7 * 24 * time.Hour
+
+// imageExts 允许发送的图片类型及其文件后缀
+var imageExts = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+// UploadMessageImage 上传图片消息的图片，返回图片在minio中的文件名
+func (r *messageRepo) UploadMessageImage(ctx context.Context, userId uint32, imageData []byte) (string, error) {
+	contentType := http.DetectContentType(imageData)
+	ext, ok := imageExts[contentType]
+	if !ok {
+		return "", ErrInvalidImage
+	}
+	imageKey := fmt.Sprintf("messages/images/%d-%d%s", userId, time.Now().UnixNano(), ext)
+	reader := bytes.NewReader(imageData)
+	err := r.data.oss.UploadSizeFile(
+		ctx, r.data.bucket, imageKey, reader, reader.Size(), minio.PutObjectOptions{
+			ContentType: contentType,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return imageKey, nil
+}
+
+// RemoveMessageImage 删除未能存储到消息中的图片，删除失败只记录日志。
+// 上传请求可能已被取消，删除不使用请求的ctx
+func (r *messageRepo) RemoveMessageImage(imageKey string) {
+	if err := r.data.oss.RemoveFile(context.Background(), r.data.bucket, imageKey); err != nil {
+		r.log.Errorf("remove message image %v err: %v", imageKey, err)
+	}
+}
+
+// duplicateImage 客户端使用同一客户端消息id重试图片消息时每次都会上传图片，
+// 返回重复消息的图片是否与已存储消息的图片不同，不同时该图片不会被任何消息引用
+func (r *messageRepo) duplicateImage(ctx context.Context, m *Message) (bool, error) {
+	if m.ImageKey == "" || m.ClientMsgId == nil {
+		return false, nil
+	}
+	var keys []string
+	err := r.data.db.WithContext(ctx).Model(&Message{}).
+		Where("from_user_id = ? AND client_msg_id = ?", m.FromUserId, *m.ClientMsgId).
+		Limit(1).Pluck("image_key", &keys).Error
+	if err != nil {
+		return false, errors.Join(ErrMysqlQuery, err)
+	}
+	return len(keys) != 0 && keys[0] != m.ImageKey, nil
+}
+
+// AttachContents 补全非文字消息的内容，图片消息生成图片地址，分享视频消息通过Publish服务获取视频。
+// Publish服务不可用时不影响获取消息，分享的视频为空
+func (r *messageRepo) AttachContents(ctx context.Context, userId uint32, ml []*biz.Message) error {
+	var videoIds []uint32
+	for _, m := range ml {
+		switch m.ContentType {
+		case biz.ContentImage:
+			if m.ImageKey == "" {
+				continue
+			}
+			url, err := r.data.oss.GetFileURL(ctx, r.data.bucket, m.ImageKey, imageUrlExpiry)
+			if err != nil {
+				return fmt.Errorf("get message image url err, %w", err)
+			}
+			m.ImageUrl = url.String()
+		case biz.ContentVideo:
+			if m.VideoId != 0 {
+				videoIds = append(videoIds, m.VideoId)
+			}
+		}
+	}
+	if len(videoIds) == 0 {
+		return nil
+	}
+	videos, err := r.publishRepo.GetVideos(ctx, userId, videoIds)
+	if err != nil {
+		r.log.Error(err)
+		return nil
+	}
+	videoMap := make(map[uint32]*biz.Video, len(videos))
+	for _, v := range videos {
+		videoMap[v.Id] = v
+	}
+	for _, m := range ml {
+		if m.ContentType == biz.ContentVideo {
+			m.Video = videoMap[m.VideoId]
+		}
+	}
+	return nil
+}
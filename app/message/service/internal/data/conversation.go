@@ -0,0 +1,119 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/toomanysource/atreus/app/message/service/internal/biz"
+)
+
+// 会话列表语句
+var (
+	// 参数依次为用户id、游标、数量，游标为0时不限制
+	conversationListQuery = "SELECT c.peer_id, c.last_message_id, m.created_at " +
+		"FROM conversations c JOIN message m ON m.id = c.last_message_id " +
+		"WHERE c.user_id = ? AND (? = 0 OR c.last_message_id < ?) " +
+		"ORDER BY c.last_message_id DESC LIMIT ?"
+	// 由已有消息回填会话，分别以发送者和接收者为会话所属用户
+	conversationBackfillSQL = []string{
+		"INSERT INTO conversations (user_id, peer_id, last_message_id) " +
+			"SELECT from_user_id, to_user_id, MAX(id) FROM message GROUP BY from_user_id, to_user_id " +
+			"ON DUPLICATE KEY UPDATE last_message_id = GREATEST(last_message_id, VALUES(last_message_id))",
+		"INSERT INTO conversations (user_id, peer_id, last_message_id) " +
+			"SELECT to_user_id, from_user_id, MAX(id) FROM message GROUP BY to_user_id, from_user_id " +
+			"ON DUPLICATE KEY UPDATE last_message_id = GREATEST(last_message_id, VALUES(last_message_id))",
+	}
+)
+
+// Conversation 用户与对方的会话，会话双方各有一条记录，由存储消息的消费者增量维护
+type Conversation struct {
+	Id            uint32 `gorm:"primary_key"`
+	UserId        uint32 `gorm:"column:user_id;not null;uniqueIndex:uk_user_peer;index:idx_user_last_message,priority:1"`
+	PeerId        uint32 `gorm:"column:peer_id;not null;uniqueIndex:uk_user_peer"`
+	LastMessageId uint32 `gorm:"column:last_message_id;not null;index:idx_user_last_message,priority:2"`
+}
+
+func (Conversation) TableName() string {
+	return "conversations"
+}
+
+// conversationRow 会话列表查询结果
+type conversationRow struct {
+	PeerId        uint32
+	LastMessageId uint32
+	CreatedAt     int64
+}
+
+// ListConversations 按最新消息从新到旧分页获取会话，cursor为上一页最后一个会话的最新消息id，
+// 返回下一页的游标，没有更多会话时为0。会话按双方的最新消息排序，展示的最新消息不包括用户删除的消息，
+// 会话中的消息都被删除时为nil，非文字消息补全图片地址和分享的视频
+func (r *messageRepo) ListConversations(
+	ctx context.Context, userId uint32, cursor uint64, count int,
+) ([]*biz.Conversation, uint64, error) {
+	var rows []*conversationRow
+	err := r.data.db.WithContext(ctx).Raw(conversationListQuery, userId, cursor, cursor, count).Scan(&rows).Error
+	if err != nil {
+		return nil, 0, errors.Join(ErrMysqlQuery, err)
+	}
+	conversations := make([]*biz.Conversation, 0, len(rows))
+	if len(rows) == 0 {
+		return conversations, 0, nil
+	}
+	peerIds := make([]uint32, 0, len(rows))
+	for _, v := range rows {
+		peerIds = append(peerIds, v.PeerId)
+	}
+	messages, err := r.latestMessages(ctx, userId, peerIds)
+	if err != nil {
+		return nil, 0, err
+	}
+	ml := make([]*biz.Message, 0, len(messages))
+	for _, m := range messages {
+		ml = append(ml, m)
+	}
+	if err = r.AttachContents(ctx, userId, ml); err != nil {
+		return nil, 0, err
+	}
+	for _, v := range rows {
+		conversations = append(conversations, &biz.Conversation{
+			ToUserId:    v.PeerId,
+			LastMessage: messages[v.PeerId],
+			UpdateTime:  v.CreatedAt,
+		})
+	}
+	var next uint64
+	if len(rows) == count {
+		next = uint64(rows[len(rows)-1].LastMessageId)
+	}
+	return conversations, next, nil
+}
+
+// updateConversations 在存储消息的事务中更新会话双方的最新消息，消息可能乱序消费，最新消息id只增不减
+func updateConversations(tx *gorm.DB, m *Message) error {
+	conversations := []*Conversation{
+		{UserId: m.FromUserId, PeerId: m.ToUserId, LastMessageId: m.Id},
+		{UserId: m.ToUserId, PeerId: m.FromUserId, LastMessageId: m.Id},
+	}
+	err := tx.Model(&Conversation{}).
+		Clauses(clause.OnConflict{DoUpdates: clause.Assignments(map[string]interface{}{
+			"last_message_id": gorm.Expr("GREATEST(last_message_id, VALUES(last_message_id))"),
+		})}).
+		Create(&conversations).Error
+	if err != nil {
+		return errors.Join(ErrMysqlInsert, err)
+	}
+	return nil
+}
+
+// backfillConversations 会话表首次创建时由已有消息生成会话
+func backfillConversations(db *gorm.DB) error {
+	for _, sql := range conversationBackfillSQL {
+		if err := db.Exec(sql).Error; err != nil {
+			return errors.Join(ErrMysqlInsert, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package data
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/toomanysource/atreus/app/message/service/internal/biz"
+	"github.com/toomanysource/atreus/pkg/sqlmockX"
+)
+
+func TestMessageRepo_ListConversations(t *testing.T) {
+	ctx := context.Background()
+	repo, mock := newMockMessageRepo(t)
+	repo.publishRepo = &mockPublishRepo{}
+	mock.ExpectQuery("FROM conversations", &sqlmockX.Rows{
+		Columns: []string{"peer_id", "last_message_id", "created_at"},
+		Values:  [][]driver.Value{{int64(3), int64(5), int64(100)}, {int64(4), int64(4), int64(90)}},
+	})
+	mock.ExpectQuery("message_deletions", &sqlmockX.Rows{
+		Columns: []string{"id", "from_user_id", "to_user_id", "created_at", "content_type", "video_id"},
+		Values:  [][]driver.Value{{int64(1), int64(2), int64(3), int64(80), int64(biz.ContentVideo), int64(7)}},
+	})
+	conversations, next, err := repo.ListConversations(ctx, 2, 0, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(4), next)
+	assert.Equal(t, 2, len(conversations))
+	// 最新消息排除用户删除的消息
+	queries := mock.Selects("message")
+	assert.Equal(t, 1, len(queries))
+	assert.Contains(t, queries[0].SQL, "message_deletions")
+	assert.Equal(t, uint64(1), conversations[0].LastMessage.Id)
+	// 分享的视频通过Publish服务补全
+	assert.Equal(t, uint32(7), conversations[0].LastMessage.Video.Id)
+	assert.Equal(t, int64(100), conversations[0].UpdateTime)
+	// 会话中的消息都被删除
+	assert.Nil(t, conversations[1].LastMessage)
+}
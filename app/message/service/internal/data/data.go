@@ -6,27 +6,43 @@ import (
 	"sync"
 
 	"github.com/toomanysource/atreus/app/message/service/internal/conf"
+	"github.com/toomanysource/atreus/pkg/minioX"
+	"github.com/toomanysource/atreus/pkg/outboxX"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
 	"github.com/google/wire"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/segmentio/kafka-go"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
 
-var ProviderSet = wire.NewSet(NewData, NewMessageRepo, NewMysqlConn, NewKafkaConn, NewRedisConn)
+var ProviderSet = wire.NewSet(NewData, NewMessageRepo, NewGroupRepo, NewMysqlConn, NewKafkaConn, NewRedisConn,
+	NewMinioConn, NewMinioExtraConn, NewMinioIntraConn)
 
 var (
-	ErrCopy             = errors.New("copy error")
-	ErrJsonMarshal      = errors.New("json marshal error")
-	ErrRedisSet         = errors.New("redis set error")
-	ErrRedisQuery       = errors.New("redis query error")
-	ErrMysqlInsert      = errors.New("mysql insert error")
-	ErrMysqlQuery       = errors.New("mysql query error")
-	ErrRedisDelete      = errors.New("redis delete error")
-	ErrRedisTransaction = errors.New("redis transaction error")
+	ErrCopy                    = errors.New("copy error")
+	ErrJsonMarshal             = errors.New("json marshal error")
+	ErrRedisSet                = errors.New("redis set error")
+	ErrRedisQuery              = errors.New("redis query error")
+	ErrMysqlInsert             = errors.New("mysql insert error")
+	ErrMysqlQuery              = errors.New("mysql query error")
+	ErrMysqlUpdate             = errors.New("mysql update error")
+	ErrMysqlDelete             = errors.New("mysql delete error")
+	ErrRedisDelete             = errors.New("redis delete error")
+	ErrRedisTransaction        = errors.New("redis transaction error")
+	ErrRedisPublish            = errors.New("redis publish error")
+	ErrRedisSubscribe          = errors.New("redis subscribe error")
+	ErrRelationServiceResponse = errors.New("relation service response error")
+	ErrPublishServiceResponse  = errors.New("publish service response error")
+	ErrUserServiceResponse     = errors.New("user service response error")
+	ErrInvalidKey              = errors.New("invalid encryption key")
+	ErrKeyRotated              = errors.New("recipient public key has been rotated")
+	ErrInvalidImage            = errors.New("invalid image")
+	ErrInvalidVideo            = errors.New("invalid video")
 )
 
 type KafkaConn struct {
@@ -35,16 +51,26 @@ type KafkaConn struct {
 }
 
 type Data struct {
-	db    *gorm.DB
-	cache *redis.Client
-	kfk   *KafkaConn
-	log   *log.Helper
+	db     *gorm.DB
+	cache  *redis.Client
+	kfk    *KafkaConn
+	oss    *minioX.Client
+	bucket string
+	log    *log.Helper
 }
 
-func NewData(db *gorm.DB, kfk *KafkaConn, cache *redis.Client, logger log.Logger) (*Data, func(), error) {
+func NewData(
+	db *gorm.DB, kfk *KafkaConn, cache *redis.Client,
+	minioClient *minioX.Client, c *conf.Minio, logger log.Logger,
+) (*Data, func(), error) {
 	logHelper := log.NewHelper(log.With(logger, "module", "data/data"))
+	// 发件箱中的消息由relay投递到Kafka
+	relay := outboxX.NewRelay(db, logger, kfk.writer)
+	relay.Start()
 
 	cleanup := func() {
+		// 先停止投递再关闭Kafka连接
+		relay.Stop()
 		var wg sync.WaitGroup
 		wg.Add(1)
 		go func() {
@@ -78,10 +104,12 @@ func NewData(db *gorm.DB, kfk *KafkaConn, cache *redis.Client, logger log.Logger
 	}
 
 	data := &Data{
-		db:    db.Model(&Message{}),
-		kfk:   kfk,
-		cache: cache,
-		log:   logHelper,
+		db:     db.Model(&Message{}),
+		kfk:    kfk,
+		cache:  cache,
+		oss:    minioClient,
+		bucket: c.BucketName,
+		log:    logHelper,
 	}
 	return data, cleanup, nil
 }
@@ -102,10 +130,12 @@ func NewMysqlConn(c *conf.Data, l log.Logger) *gorm.DB {
 
 func NewKafkaConn(c *conf.Data, l log.Logger) *KafkaConn {
 	logs := log.NewHelper(log.With(l, "module", "data/data/kafka"))
+	// 同一会话的消息key相同，写入同一分区以保证顺序，所有副本确认后才视为发送成功
 	writer := kafka.Writer{
 		Addr:                   kafka.TCP(c.Kafka.Addr),
 		Topic:                  c.Kafka.Topic,
-		Balancer:               &kafka.LeastBytes{},
+		Balancer:               &kafka.Hash{},
+		RequiredAcks:           kafka.RequireAll,
 		WriteTimeout:           c.Kafka.WriteTimeout.AsDuration(),
 		ReadTimeout:            c.Kafka.ReadTimeout.AsDuration(),
 		AllowAutoTopicCreation: true,
@@ -145,9 +175,55 @@ func NewRedisConn(c *conf.Data, l log.Logger) (cacheClient *redis.Client) {
 	return cache
 }
 
-// InitDB 创建followers数据表，并自动迁移
+func NewMinioConn(c *conf.Minio, extraConn minioX.ExtraConn, intraConn minioX.IntraConn, l log.Logger) *minioX.Client {
+	logs := log.NewHelper(log.With(l, "module", "data/data/minio"))
+	client := minioX.NewClient(extraConn, intraConn)
+	if err := client.CreateBucket(context.Background(), c.BucketName); err != nil {
+		logs.Fatal(err)
+	}
+	logs.Info("minio enabled successfully")
+	return client
+}
+
+func NewMinioExtraConn(c *conf.Minio, l log.Logger) minioX.ExtraConn {
+	logs := log.NewHelper(log.With(l, "module", "data/data/minioExtra"))
+	extraConn, err := minio.New(c.EndpointExtra, &minio.Options{
+		Creds:  credentials.NewStaticV4(c.AccessKeyId, c.AccessSecret, ""),
+		Secure: c.UseSsl,
+	})
+	if err != nil {
+		logs.Fatalf("minio client init failed,err: %v", err)
+	}
+	return minioX.NewExtraConn(extraConn)
+}
+
+func NewMinioIntraConn(c *conf.Minio, l log.Logger) minioX.IntraConn {
+	logs := log.NewHelper(log.With(l, "module", "data/data/minioIntra"))
+	intraConn, err := minio.New(c.EndpointIntra, &minio.Options{
+		Creds:  credentials.NewStaticV4(c.AccessKeyId, c.AccessSecret, ""),
+		Secure: c.UseSsl,
+	})
+	if err != nil {
+		logs.Fatalf("minio client init failed,err: %v", err)
+	}
+	return minioX.NewIntraConn(intraConn)
+}
+
+// InitDB 创建私聊消息、已读位置、会话、群聊和发件箱数据表，并自动迁移
 func InitDB(db *gorm.DB) {
-	if err := db.AutoMigrate(&Message{}); err != nil {
+	// 会话表首次创建时需要由已有消息回填
+	backfill := !db.Migrator().HasTable(&Conversation{})
+	err := db.AutoMigrate(&Message{}, &MessageRead{}, &Conversation{}, &Group{}, &GroupMember{}, &GroupMessage{},
+		&MessageDeletion{})
+	if err != nil {
 		log.Fatalf("database initialization error, err : %v", err)
 	}
+	if backfill {
+		if err := backfillConversations(db); err != nil {
+			log.Fatalf("conversation backfill error, err : %v", err)
+		}
+	}
+	if err := outboxX.InitDB(db); err != nil {
+		log.Fatalf("outbox initialization error, err : %v", err)
+	}
 }
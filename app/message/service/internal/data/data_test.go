@@ -0,0 +1,71 @@
+package data
+
+import (
+	"context"
+	"database/sql/driver"
+	"os"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+
+	"github.com/toomanysource/atreus/app/message/service/internal/biz"
+	"github.com/toomanysource/atreus/pkg/sqlmockX"
+)
+
+func newMockMessageRepo(t *testing.T) (*messageRepo, *sqlmockX.Mock) {
+	db, mock := sqlmockX.New(t)
+	return &messageRepo{
+		data: &Data{db: db},
+		log:  log.NewHelper(log.DefaultLogger),
+	}, mock
+}
+
+func messageRows(createTime int64, recalled bool) *sqlmockX.Rows {
+	return &sqlmockX.Rows{
+		Columns: []string{"id", "from_user_id", "to_user_id", "content", "created_at", "recalled"},
+		Values:  [][]driver.Value{{int64(1), int64(2), int64(3), "hello", createTime, recalled}},
+	}
+}
+
+type mockPublishRepo struct{}
+
+func (m *mockPublishRepo) GetVideos(ctx context.Context, userId uint32, videoIds []uint32) ([]*biz.Video, error) {
+	videos := make([]*biz.Video, 0, len(videoIds))
+	for _, id := range videoIds {
+		videos = append(videos, &biz.Video{Id: id})
+	}
+	return videos, nil
+}
+
+// 测试使用的用户id，避免与真实数据冲突
+const (
+	testUserId   = 1<<31 + 1
+	testToUserId = 1<<31 + 2
+)
+
+// newRedisRepo 连接ATREUS_REDIS_ADDR指定的测试Redis，未指定或连接失败时跳过测试，避免写入本地Redis。
+// 数据库使用sqlmockX，keys在测试前后删除
+func newRedisRepo(t *testing.T, keys ...string) (*messageRepo, *sqlmockX.Mock) {
+	addr := os.Getenv("ATREUS_REDIS_ADDR")
+	if addr == "" {
+		t.Skip("ATREUS_REDIS_ADDR is not set")
+	}
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		t.Skipf("redis %v is unavailable: %v", addr, err)
+	}
+	cleanup := func() {
+		for _, key := range keys {
+			client.Del(context.Background(), key, mutexKeyPrefix+key)
+		}
+	}
+	cleanup()
+	t.Cleanup(func() {
+		cleanup()
+		client.Close()
+	})
+	repo, mock := newMockMessageRepo(t)
+	repo.data.cache = client
+	return repo, mock
+}
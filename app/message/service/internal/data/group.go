@@ -0,0 +1,299 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/toomanysource/atreus/app/message/service/internal/biz"
+	"github.com/toomanysource/atreus/middleware"
+	"github.com/toomanysource/atreus/pkg/outboxX"
+)
+
+// Group 群聊，groups是MySQL保留字，因此表名为chat_groups
+type Group struct {
+	Id         uint32 `gorm:"primary_key"`
+	Name       string `gorm:"column:name;type:varchar(64);not null"`
+	OwnerId    uint32 `gorm:"column:owner_id;not null"`
+	CreateTime int64  `gorm:"column:created_at"`
+}
+
+func (Group) TableName() string {
+	return "chat_groups"
+}
+
+// GroupMember 群成员及其角色
+type GroupMember struct {
+	Id       uint32 `gorm:"primary_key"`
+	GroupId  uint32 `gorm:"column:group_id;not null;uniqueIndex:uk_group_user"`
+	UserId   uint32 `gorm:"column:user_id;not null;uniqueIndex:uk_group_user;index:idx_user_id"`
+	Role     uint32 `gorm:"column:role;not null;default:0"`
+	JoinTime int64  `gorm:"column:joined_at"`
+}
+
+func (GroupMember) TableName() string {
+	return "group_members"
+}
+
+// GroupMessage 群消息，同一群内按id递增存储
+type GroupMessage struct {
+	Id         uint32 `gorm:"column:id;primary_key;auto_increment"`
+	GroupId    uint32 `gorm:"column:group_id;not null;index:idx_group_id"`
+	FromUserId uint32 `gorm:"column:from_user_id;not null;uniqueIndex:uk_from_user_client_msg,priority:1"`
+	Content    string `gorm:"column:content;not null"`
+	CreateTime int64  `gorm:"column:created_at"`
+	// 客户端消息id，同一发送者的消息id唯一，用于重复消费时去重，已有消息为NULL
+	ClientMsgId *string `gorm:"column:client_msg_id;size:64;uniqueIndex:uk_from_user_client_msg,priority:2"`
+}
+
+func (GroupMessage) TableName() string {
+	return "group_messages"
+}
+
+type groupRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewGroupRepo(data *Data, logger log.Logger) biz.GroupRepo {
+	return &groupRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data/group")),
+	}
+}
+
+// CreateGroup 在同一事务中创建群聊和群成员
+func (r *groupRepo) CreateGroup(ctx context.Context, name string, ownerId uint32, memberIds []uint32) (*biz.Group, error) {
+	now := time.Now().UnixMilli()
+	g := &Group{Name: name, OwnerId: ownerId, CreateTime: now}
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&Group{}).Create(g).Error; err != nil {
+			return errors.Join(ErrMysqlInsert, err)
+		}
+		members := make([]*GroupMember, 0, len(memberIds)+1)
+		members = append(members, &GroupMember{GroupId: g.Id, UserId: ownerId, Role: biz.RoleOwner, JoinTime: now})
+		for _, id := range memberIds {
+			members = append(members, &GroupMember{GroupId: g.Id, UserId: id, Role: biz.RoleMember, JoinTime: now})
+		}
+		if err := tx.Model(&GroupMember{}).Create(&members).Error; err != nil {
+			return errors.Join(ErrMysqlInsert, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &biz.Group{
+		Id:          g.Id,
+		Name:        g.Name,
+		OwnerId:     g.OwnerId,
+		MemberCount: uint32(len(memberIds) + 1),
+		CreateTime:  g.CreateTime,
+	}, nil
+}
+
+// RenameGroup 修改群名称
+func (r *groupRepo) RenameGroup(ctx context.Context, groupId uint32, name string) error {
+	err := r.data.db.WithContext(ctx).Model(&Group{}).Where("id = ?", groupId).Update("name", name).Error
+	if err != nil {
+		return errors.Join(ErrMysqlUpdate, err)
+	}
+	return nil
+}
+
+// GetGroupMember 获取群成员信息，不是群成员时返回nil
+func (r *groupRepo) GetGroupMember(ctx context.Context, groupId, userId uint32) (*biz.GroupMember, error) {
+	var members []*GroupMember
+	err := r.data.db.WithContext(ctx).Model(&GroupMember{}).
+		Where("group_id = ? AND user_id = ?", groupId, userId).Limit(1).Find(&members).Error
+	if err != nil {
+		return nil, errors.Join(ErrMysqlQuery, err)
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+	return &biz.GroupMember{GroupId: groupId, UserId: userId, Role: members[0].Role}, nil
+}
+
+// AddGroupMembers 添加普通成员，已在群中的用户保持原角色且不计入新增人数，返回实际加入的人数。
+// 锁定群聊后在同一事务中统计人数并插入，加入后超过limit时返回biz.ErrGroupFull，群聊不存在时不添加
+func (r *groupRepo) AddGroupMembers(ctx context.Context, groupId uint32, userIds []uint32, limit int) (int, error) {
+	added := 0
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var groups []*Group
+		err := tx.Model(&Group{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", groupId).Limit(1).Find(&groups).Error
+		if err != nil {
+			return errors.Join(ErrMysqlQuery, err)
+		}
+		if len(groups) == 0 {
+			return nil
+		}
+		var count int64
+		if err = tx.Model(&GroupMember{}).Where("group_id = ?", groupId).Count(&count).Error; err != nil {
+			return errors.Join(ErrMysqlQuery, err)
+		}
+		var existing []uint32
+		err = tx.Model(&GroupMember{}).Where("group_id = ? AND user_id IN ?", groupId, userIds).
+			Pluck("user_id", &existing).Error
+		if err != nil {
+			return errors.Join(ErrMysqlQuery, err)
+		}
+		joined := make(map[uint32]struct{}, len(existing))
+		for _, id := range existing {
+			joined[id] = struct{}{}
+		}
+		now := time.Now().UnixMilli()
+		members := make([]*GroupMember, 0, len(userIds))
+		for _, id := range userIds {
+			if _, ok := joined[id]; ok {
+				continue
+			}
+			members = append(members, &GroupMember{GroupId: groupId, UserId: id, Role: biz.RoleMember, JoinTime: now})
+		}
+		if len(members) == 0 {
+			return nil
+		}
+		if int(count)+len(members) > limit {
+			return biz.ErrGroupFull
+		}
+		result := tx.Model(&GroupMember{}).Clauses(clause.OnConflict{DoNothing: true}).Create(&members)
+		if result.Error != nil {
+			return errors.Join(ErrMysqlInsert, result.Error)
+		}
+		added = int(result.RowsAffected)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return added, nil
+}
+
+// RemoveGroupMember 移除群成员，返回是否真正移除了成员
+func (r *groupRepo) RemoveGroupMember(ctx context.Context, groupId, userId uint32) (bool, error) {
+	result := r.data.db.WithContext(ctx).Where("group_id = ? AND user_id = ?", groupId, userId).Delete(&GroupMember{})
+	if result.Error != nil {
+		return false, errors.Join(ErrMysqlDelete, result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// LeaveGroup 退出群聊，群主退出时在同一事务中转让给角色最高且最早加入的成员，没有其他成员时解散群聊
+func (r *groupRepo) LeaveGroup(ctx context.Context, groupId, userId uint32) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var self GroupMember
+		result := tx.Model(&GroupMember{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("group_id = ? AND user_id = ?", groupId, userId).Limit(1).Find(&self)
+		if result.Error != nil {
+			return errors.Join(ErrMysqlQuery, result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		if err := tx.Delete(&GroupMember{}, self.Id).Error; err != nil {
+			return errors.Join(ErrMysqlDelete, err)
+		}
+		if self.Role != biz.RoleOwner {
+			return nil
+		}
+		var next GroupMember
+		result = tx.Model(&GroupMember{}).Where("group_id = ?", groupId).
+			Order("role DESC, id").Limit(1).Find(&next)
+		if result.Error != nil {
+			return errors.Join(ErrMysqlQuery, result.Error)
+		}
+		if result.RowsAffected == 0 {
+			if err := tx.Delete(&Group{}, groupId).Error; err != nil {
+				return errors.Join(ErrMysqlDelete, err)
+			}
+			return nil
+		}
+		if err := tx.Model(&GroupMember{}).Where("id = ?", next.Id).Update("role", biz.RoleOwner).Error; err != nil {
+			return errors.Join(ErrMysqlUpdate, err)
+		}
+		if err := tx.Model(&Group{}).Where("id = ?", groupId).Update("owner_id", next.UserId).Error; err != nil {
+			return errors.Join(ErrMysqlUpdate, err)
+		}
+		return nil
+	})
+}
+
+// PublishGroupMessage 发送群消息，经过发件箱写入与私聊消息相同的存储队列，以群id为key保证群内消息顺序
+func (r *groupRepo) PublishGroupMessage(ctx context.Context, groupId uint32, content, clientMsgId string) error {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	byteValue, err := json.Marshal(&storeMessage{
+		FromUserId:  userId,
+		GroupId:     groupId,
+		Content:     content,
+		CreateTime:  time.Now().UnixMilli(),
+		ClientMsgId: clientMsgId,
+	})
+	if err != nil {
+		return errors.Join(ErrJsonMarshal, err)
+	}
+	return outboxX.Add(r.data.db.WithContext(ctx), r.data.kfk.writer.Topic, groupKey(groupId), string(byteValue))
+}
+
+// GetGroupMessages 按id从新到旧分页获取群消息，cursor为上一页最后一条消息的id，
+// 返回下一页的游标，没有更多消息时为0
+func (r *groupRepo) GetGroupMessages(
+	ctx context.Context, groupId uint32, cursor uint64, count int,
+) ([]*biz.GroupMessage, uint64, error) {
+	db := r.data.db.WithContext(ctx).Model(&GroupMessage{}).Where("group_id = ?", groupId)
+	if cursor != 0 {
+		db = db.Where("id < ?", cursor)
+	}
+	var gml []*GroupMessage
+	if err := db.Order("id DESC").Limit(count).Find(&gml).Error; err != nil {
+		return nil, 0, errors.Join(ErrMysqlQuery, err)
+	}
+	messages := make([]*biz.GroupMessage, 0, len(gml))
+	for _, m := range gml {
+		messages = append(messages, toBizGroupMessage(m))
+	}
+	var next uint64
+	if len(gml) == count {
+		next = uint64(gml[len(gml)-1].Id)
+	}
+	return messages, next, nil
+}
+
+// insertGroupMessage 存储群消息，返回存储时的全部群成员用于推送和是否插入了新消息，
+// 同一发送者的客户端消息id已存在时不插入
+func insertGroupMessage(ctx context.Context, db *gorm.DB, m *GroupMessage) ([]uint32, bool, error) {
+	result := db.WithContext(ctx).Model(&GroupMessage{}).Clauses(clause.OnConflict{DoNothing: true}).Create(m)
+	if result.Error != nil {
+		return nil, false, errors.Join(ErrMysqlInsert, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, false, nil
+	}
+	var memberIds []uint32
+	err := db.WithContext(ctx).Model(&GroupMember{}).Where("group_id = ?", m.GroupId).Pluck("user_id", &memberIds).Error
+	if err != nil {
+		return nil, false, errors.Join(ErrMysqlQuery, err)
+	}
+	return memberIds, true, nil
+}
+
+func toBizGroupMessage(m *GroupMessage) *biz.GroupMessage {
+	return &biz.GroupMessage{
+		Id:         uint64(m.Id),
+		GroupId:    m.GroupId,
+		FromUserId: m.FromUserId,
+		Content:    m.Content,
+		CreateTime: m.CreateTime,
+	}
+}
+
+// groupKey 群消息在存储队列中的key
+func groupKey(groupId uint32) string {
+	return "group:" + strconv.Itoa(int(groupId))
+}
@@ -0,0 +1,98 @@
+package data
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/toomanysource/atreus/app/message/service/internal/biz"
+	"github.com/toomanysource/atreus/pkg/sqlmockX"
+)
+
+func TestInsertGroupMessage(t *testing.T) {
+	ctx := context.Background()
+	clientMsgId := "client-1"
+
+	db, mock := sqlmockX.New(t)
+	mock.ExpectQuery("FROM `group_members`", &sqlmockX.Rows{
+		Columns: []string{"user_id"},
+		Values:  [][]driver.Value{{int64(1)}, {int64(2)}},
+	})
+	m := &GroupMessage{GroupId: 1, FromUserId: 1, ClientMsgId: &clientMsgId}
+	memberIds, inserted, err := insertGroupMessage(ctx, db, m)
+	assert.Nil(t, err)
+	assert.True(t, inserted)
+	assert.Equal(t, []uint32{1, 2}, memberIds)
+	inserts := mock.Inserts("group_messages")
+	assert.Equal(t, 1, len(inserts))
+	assert.Equal(t, clientMsgId, inserts[0]["client_msg_id"])
+
+	// 重复投递的消息不插入，也不查询群成员
+	db, mock = sqlmockX.New(t)
+	mock.ExpectExec("INSERT INTO `group_messages`", 0)
+	m = &GroupMessage{GroupId: 1, FromUserId: 1, ClientMsgId: &clientMsgId}
+	memberIds, inserted, err = insertGroupMessage(ctx, db, m)
+	assert.Nil(t, err)
+	assert.False(t, inserted)
+	assert.Empty(t, memberIds)
+	assert.Empty(t, mock.Selects("group_members"))
+}
+
+func TestGroupRepo_AddGroupMembers(t *testing.T) {
+	ctx := context.Background()
+	newRepo := func() (*groupRepo, *sqlmockX.Mock) {
+		db, mock := sqlmockX.New(t)
+		mock.ExpectQuery("FROM `chat_groups`", &sqlmockX.Rows{
+			Columns: []string{"id", "name", "owner_id"},
+			Values:  [][]driver.Value{{int64(1), "group", int64(1)}},
+		})
+		return &groupRepo{data: &Data{db: db}, log: log.NewHelper(log.DefaultLogger)}, mock
+	}
+	count := func(n int64) *sqlmockX.Rows {
+		return &sqlmockX.Rows{Columns: []string{"count"}, Values: [][]driver.Value{{n}}}
+	}
+	existing := &sqlmockX.Rows{Columns: []string{"user_id"}, Values: [][]driver.Value{{int64(2)}}}
+
+	// 锁定群聊后统计人数，已在群中的用户不计入上限也不插入
+	repo, mock := newRepo()
+	mock.ExpectQuery("count(*)", count(3))
+	mock.ExpectQuery("user_id IN", existing)
+	mock.ExpectExec("INSERT INTO `group_members`", 1)
+	added, err := repo.AddGroupMembers(ctx, 1, []uint32{2, 4}, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, added)
+	locks := mock.Selects("chat_groups")
+	assert.Len(t, locks, 1)
+	assert.True(t, locks[0].Locked())
+	inserts := mock.Inserts("group_members")
+	assert.Len(t, inserts, 1)
+	assert.Equal(t, int64(4), inserts[0]["user_id"])
+
+	// 新用户加入后超过上限
+	repo, mock = newRepo()
+	mock.ExpectQuery("count(*)", count(4))
+	mock.ExpectQuery("user_id IN", existing)
+	_, err = repo.AddGroupMembers(ctx, 1, []uint32{2, 4}, 4)
+	assert.ErrorIs(t, err, biz.ErrGroupFull)
+	assert.Empty(t, mock.Inserts("group_members"))
+
+	// 群已满时邀请已在群中的用户不报错
+	repo, mock = newRepo()
+	mock.ExpectQuery("count(*)", count(4))
+	mock.ExpectQuery("user_id IN", existing)
+	added, err = repo.AddGroupMembers(ctx, 1, []uint32{2}, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, added)
+	assert.Empty(t, mock.Inserts("group_members"))
+
+	// 群聊不存在
+	db, mock := sqlmockX.New(t)
+	repo = &groupRepo{data: &Data{db: db}, log: log.NewHelper(log.DefaultLogger)}
+	added, err = repo.AddGroupMembers(ctx, 1, []uint32{4}, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, added)
+	assert.Empty(t, mock.Inserts("group_members"))
+}
@@ -9,6 +9,9 @@ import (
 	"strconv"
 	"time"
 
+	publishv1 "github.com/toomanysource/atreus/api/publish/service/v1"
+	relationv1 "github.com/toomanysource/atreus/api/relation/service/v1"
+	userv1 "github.com/toomanysource/atreus/api/user/service/v1"
 	"github.com/toomanysource/atreus/pkg/kafkaX"
 
 	"github.com/toomanysource/atreus/middleware"
@@ -16,8 +19,12 @@ import (
 	"github.com/toomanysource/atreus/app/message/service/internal/biz"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
 	"github.com/jinzhu/copier"
 	"github.com/segmentio/kafka-go"
+	"golang.org/x/sync/singleflight"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -28,53 +35,177 @@ const (
 	RandTimeEnd   = 720
 )
 
-var ErrMsgYourself = errors.New("can't send message to yourself")
+// mutexKeyPrefix 会话缓存重建锁的key前缀，锁的值为持有者的随机token
+const mutexKeyPrefix = "mutex:"
+
+// mutexExpiration 会话缓存重建锁的过期时间，重建超过该时间时放弃，避免锁过期后与其他实例同时重建
+const mutexExpiration = time.Second * timeFactor
+
+// unlockScript 只有锁仍由token持有时才删除，避免锁过期后误删其他请求持有的锁
+var unlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+var (
+	ErrMsgYourself    = errors.New("can't send message to yourself")
+	ErrBlocked        = errors.New("you are blocked by the user")
+	ErrInvalidMessage = errors.New("message not found")
+)
 
 type Message struct {
 	Id         uint32 `gorm:"column:id;primary_key;auto_increment"`
-	FromUserId uint32 `gorm:"column:from_user_id;not null;index:idx_from_user_to_user"`
-	ToUserId   uint32 `gorm:"column:to_user_id;not null;index:idx_from_user_to_user"`
+	FromUserId uint32 `gorm:"column:from_user_id;not null;index:idx_from_user_to_user;index:idx_to_user_from_user,priority:2;uniqueIndex:uk_from_user_client_msg,priority:1"`
+	ToUserId   uint32 `gorm:"column:to_user_id;not null;index:idx_from_user_to_user;index:idx_to_user_from_user,priority:1"`
 	Content    string `gorm:"column:content;not null"`
 	CreateTime int64  `gorm:"column:created_at"`
+	Recalled   bool   `gorm:"column:recalled;not null;default:false"`
+	// 消息类型，已有消息的类型为0即文字消息
+	ContentType uint32 `gorm:"column:content_type;not null;default:0"`
+	ImageKey    string `gorm:"column:image_key;not null;default:''"`
+	VideoId     uint32 `gorm:"column:video_id;not null;default:0"`
+	StickerId   uint32 `gorm:"column:sticker_id;not null;default:0"`
+	// 客户端消息id，同一发送者的消息id唯一，用于重复消费时去重，已有消息为NULL
+	ClientMsgId *string `gorm:"column:client_msg_id;size:64;uniqueIndex:uk_from_user_client_msg,priority:2"`
+	// 加密消息使用的双方公钥id，加密消息的内容为密文
+	SenderKeyId    uint32 `gorm:"column:sender_key_id;not null;default:0"`
+	RecipientKeyId uint32 `gorm:"column:recipient_key_id;not null;default:0"`
 }
 
 func (Message) TableName() string {
 	return "message"
 }
 
+// storeMessage 存储队列中的消息，GroupId不为0时为群消息，ToUserId为0。
+// 非文字消息的字段可以省略，省略时为文字消息
+type storeMessage struct {
+	FromUserId  uint32
+	ToUserId    uint32
+	GroupId     uint32 `json:",omitempty"`
+	Content     string
+	CreateTime  int64
+	ContentType uint32 `json:",omitempty"`
+	ImageKey    string `json:",omitempty"`
+	VideoId     uint32 `json:",omitempty"`
+	StickerId   uint32 `json:",omitempty"`
+	ClientMsgId string `json:",omitempty"`
+	// 加密消息使用的双方公钥id
+	SenderKeyId    uint32 `json:",omitempty"`
+	RecipientKeyId uint32 `json:",omitempty"`
+}
+
+type RelationRepo interface {
+	IsBlockedByReceiver(ctx context.Context, fromUserId, toUserId uint32) (bool, error)
+}
+
+type PublishRepo interface {
+	GetVideos(ctx context.Context, userId uint32, videoIds []uint32) ([]*biz.Video, error)
+}
+
+type UserRepo interface {
+	CheckKeys(ctx context.Context, userId, toUserId, senderKeyId, recipientKeyId uint32) error
+}
+
 type messageRepo struct {
-	data *Data
-	log  *log.Helper
+	data         *Data
+	relationRepo RelationRepo
+	publishRepo  PublishRepo
+	userRepo     UserRepo
+	rebuild      singleflight.Group // 合并同一实例内对同一会话缓存的并发重建
+	log          *log.Helper
 }
 
-func NewMessageRepo(data *Data, logger log.Logger) biz.MessageRepo {
+func NewMessageRepo(
+	data *Data, relationConn relationv1.RelationServiceClient, publishConn publishv1.PublishServiceClient,
+	userConn userv1.UserServiceClient, logger log.Logger,
+) biz.MessageRepo {
 	return &messageRepo{
-		data: data,
-		log:  log.NewHelper(log.With(logger, "module", "data/message")),
+		data:         data,
+		relationRepo: NewRelationRepo(relationConn),
+		publishRepo:  NewPublishRepo(publishConn),
+		userRepo:     NewUserRepo(userConn),
+		log:          log.NewHelper(log.With(logger, "module", "data/message")),
 	}
 }
 
-// PublishMessage 发送消息
-func (r *messageRepo) PublishMessage(ctx context.Context, toUserId uint32, content string) error {
+// PublishMessage 发送消息，图片消息先上传图片，分享视频消息先校验视频是否存在，加密消息先校验公钥。
+// 消息被Kafka确认后才返回，失败时客户端可以使用同一客户端消息id重试
+func (r *messageRepo) PublishMessage(ctx context.Context, toUserId uint32, payload *biz.Payload) error {
 	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
 	if userId == toUserId {
 		return ErrMsgYourself
 	}
-	createTime := time.Now().UnixMilli()
-	go func() {
-		err := r.MessageProducer(userId, toUserId, content, createTime)
+	// 被对方屏蔽时不能发送消息
+	blocked, err := r.relationRepo.IsBlockedByReceiver(ctx, userId, toUserId)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return ErrBlocked
+	}
+	mg := &storeMessage{
+		FromUserId:     userId,
+		ToUserId:       toUserId,
+		Content:        payload.Content,
+		ContentType:    payload.ContentType,
+		VideoId:        payload.VideoId,
+		StickerId:      payload.StickerId,
+		ClientMsgId:    payload.ClientMsgId,
+		SenderKeyId:    payload.SenderKeyId,
+		RecipientKeyId: payload.RecipientKeyId,
+	}
+	switch payload.ContentType {
+	case biz.ContentImage:
+		if mg.ImageKey, err = r.UploadMessageImage(ctx, userId, payload.ImageData); err != nil {
+			return err
+		}
+	case biz.ContentVideo:
+		videos, err := r.publishRepo.GetVideos(ctx, userId, []uint32{payload.VideoId})
 		if err != nil {
-			r.log.Errorf("message producer error, err: %w", err)
-			return
+			return err
 		}
-	}()
+		if len(videos) == 0 {
+			return ErrInvalidVideo
+		}
+	case biz.ContentEncrypted:
+		err = r.userRepo.CheckKeys(ctx, userId, toUserId, payload.SenderKeyId, payload.RecipientKeyId)
+		if err != nil {
+			return err
+		}
+	}
+	mg.CreateTime = time.Now().UnixMilli()
+	if err = r.MessageProducer(ctx, mg); err != nil {
+		// 写入失败的消息不会被存储，删除已上传的图片，客户端重试时重新上传
+		if mg.ImageKey != "" {
+			r.RemoveMessageImage(mg.ImageKey)
+		}
+		return err
+	}
 	return nil
 }
 
-// GetMessageList 获取聊天记录列表
+// GetMessageList 获取聊天记录列表，不包括当前用户删除的消息
 func (r *messageRepo) GetMessageList(ctx context.Context, toUserId uint32, preMsgTime int64) ([]*biz.Message, error) {
-	// 先在redis缓存中查询是否存在聊天记录列表
 	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	ml, err := r.getMessageList(ctx, userId, toUserId, preMsgTime)
+	if err != nil {
+		return nil, err
+	}
+	if ml, err = r.filterDeleted(ctx, userId, ml); err != nil {
+		return nil, err
+	}
+	if err = r.AttachContents(ctx, userId, ml); err != nil {
+		return nil, err
+	}
+	return ml, nil
+}
+
+// getMessageList 获取两个用户之间的聊天记录列表
+func (r *messageRepo) getMessageList(
+	ctx context.Context, userId, toUserId uint32, preMsgTime int64,
+) ([]*biz.Message, error) {
+	// 先在redis缓存中查询是否存在聊天记录列表
 	key := setKey(userId, toUserId)
 	ok, err := r.CheckCache(ctx, key)
 	if err != nil {
@@ -83,51 +214,66 @@ func (r *messageRepo) GetMessageList(ctx context.Context, toUserId uint32, preMs
 	if ok {
 		return r.GetCache(ctx, userId, toUserId, preMsgTime)
 	}
-	// 加锁防止私聊两用户同时请求导致重复创建
-	ok, err = r.AddCacheMutex(ctx)
+	// 同一会话的并发请求等待同一次重建，重建与发起请求的ctx无关，避免一个请求取消导致其他请求失败
+	_, err, _ = r.rebuild.Do(key, func() (interface{}, error) {
+		return nil, r.RebuildCache(context.Background(), key, userId, toUserId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	ok, err = r.CheckCache(ctx, key)
 	if err != nil {
 		return nil, err
 	}
 	if ok {
-		ok, err = r.CheckCache(ctx, key)
-		if err != nil {
-			return nil, err
-		}
-		if ok {
-			return r.GetCache(ctx, userId, toUserId, preMsgTime)
-		}
-		cl, err := r.GetMessages(ctx, userId, toUserId, preMsgTime)
-		if err != nil {
-			return nil, err
-		}
-		go func() {
-			if err = r.CreateCacheByTran(ctx, cl, key); err != nil {
-				r.log.Error(err)
-				return
-			}
-			if err = r.DelCacheMutex(ctx); err != nil {
-				r.log.Error(err)
-				return
-			}
-			r.log.Info("redis transaction success")
-		}()
-		return cl, nil
+		return r.GetCache(ctx, userId, toUserId, preMsgTime)
 	}
+	// 其他实例正在重建缓存
 	return r.GetMessages(ctx, userId, toUserId, preMsgTime)
 }
 
-// AddCacheMutex 缓存加锁
-func (r *messageRepo) AddCacheMutex(ctx context.Context) (bool, error) {
-	ok, err := r.data.cache.SetNX(ctx, "mutex", "", time.Second*time.Duration(timeFactor)).Result()
+// RebuildCache 持有会话锁时由数据库重建会话的完整聊天记录缓存，其他实例持有锁时直接返回，
+// 重建的时间不超过锁的过期时间
+func (r *messageRepo) RebuildCache(ctx context.Context, key string, userId, toUserId uint32) error {
+	token := uuid.NewString()
+	ok, err := r.AddCacheMutex(ctx, key, token)
+	if err != nil || !ok {
+		return err
+	}
+	defer func() {
+		if err := r.DelCacheMutex(ctx, key, token); err != nil {
+			r.log.Error(err)
+		}
+	}()
+	rebuildCtx, cancel := context.WithTimeout(ctx, mutexExpiration)
+	defer cancel()
+	// 检查缓存后到加锁前缓存可能已被其他请求重建
+	if ok, err = r.CheckCache(rebuildCtx, key); err != nil || ok {
+		return err
+	}
+	ml, err := r.GetMessages(rebuildCtx, userId, toUserId, 0)
+	if err != nil {
+		return err
+	}
+	if err = r.CreateCacheByTran(rebuildCtx, ml, key); err != nil {
+		return err
+	}
+	r.log.Info("redis transaction success")
+	return nil
+}
+
+// AddCacheMutex 会话缓存加锁，token标识锁的持有者
+func (r *messageRepo) AddCacheMutex(ctx context.Context, key, token string) (bool, error) {
+	ok, err := r.data.cache.SetNX(ctx, mutexKeyPrefix+key, token, mutexExpiration).Result()
 	if err != nil {
 		return false, errors.Join(ErrRedisSet, err)
 	}
 	return ok, nil
 }
 
-// DelCacheMutex 缓存解锁
-func (r *messageRepo) DelCacheMutex(ctx context.Context) error {
-	_, err := r.data.cache.Del(ctx, "mutex").Result()
+// DelCacheMutex 会话缓存解锁，锁已不由token持有时不做任何操作
+func (r *messageRepo) DelCacheMutex(ctx context.Context, key, token string) error {
+	err := unlockScript.Run(ctx, r.data.cache, []string{mutexKeyPrefix + key}, token).Err()
 	if err != nil {
 		return errors.Join(ErrRedisDelete, err)
 	}
@@ -170,54 +316,112 @@ func (r *messageRepo) GetCache(ctx context.Context, userId, toUserId uint32, pre
 	return cl, nil
 }
 
-// MessageProducer 生产消息
-func (r *messageRepo) MessageProducer(userId, toUserId uint32, content string, time int64) error {
-	mg := &Message{
-		FromUserId: userId,
-		ToUserId:   toUserId,
-		Content:    content,
-		CreateTime: time,
-	}
+// MessageProducer 同步生产消息，以会话key作为消息key，同一会话的消息进入同一分区并按发送顺序消费
+func (r *messageRepo) MessageProducer(ctx context.Context, mg *storeMessage) error {
 	byteValue, err := json.Marshal(mg)
 	if err != nil {
 		return errors.Join(ErrJsonMarshal, err)
 	}
-	return kafkaX.Update(r.data.kfk.writer, "", string(byteValue))
+	return kafkaX.UpdateContext(ctx, r.data.kfk.writer, setKey(mg.FromUserId, mg.ToUserId), string(byteValue))
 }
 
 // InitStoreMessageQueue 初始化聊天记录存储队列
 func (r *messageRepo) InitStoreMessageQueue() {
-	kafkaX.Reader(r.data.kfk.reader, r.log, func(ctx context.Context, reader *kafka.Reader, msg kafka.Message) {
-		value := msg.Value
-		var mg *Message
-		err := json.Unmarshal(value, &mg)
+	kafkaX.Reader(r.data.kfk.reader, r.log, func(ctx context.Context, reader *kafka.Reader, msg kafka.Message) error {
+		return r.storeMessage(ctx, msg.Value)
+	})
+}
+
+// storeMessage 存储队列中的一条消息，存储失败时返回错误，由队列重试且不提交偏移量。
+// 客户端消息id保证重复存储是安全的，无法解析的消息记录日志后跳过
+func (r *messageRepo) storeMessage(ctx context.Context, value []byte) error {
+	var mg *storeMessage
+	if err := json.Unmarshal(value, &mg); err != nil {
+		r.log.Error(errors.Join(ErrJsonMarshal, err))
+		return nil
+	}
+	if mg.GroupId != 0 {
+		return r.storeGroupMessage(ctx, mg)
+	}
+	m := &Message{
+		FromUserId:     mg.FromUserId,
+		ToUserId:       mg.ToUserId,
+		Content:        mg.Content,
+		CreateTime:     mg.CreateTime,
+		ContentType:    mg.ContentType,
+		ImageKey:       mg.ImageKey,
+		VideoId:        mg.VideoId,
+		StickerId:      mg.StickerId,
+		SenderKeyId:    mg.SenderKeyId,
+		RecipientKeyId: mg.RecipientKeyId,
+	}
+	if mg.ClientMsgId != "" {
+		m.ClientMsgId = &mg.ClientMsgId
+	}
+	inserted, err := r.InsertMessage(ctx, m)
+	if err != nil {
+		return err
+	}
+	// 重复投递的消息已经存储并通知过
+	if !inserted {
+		r.log.Infof("duplicate message skipped, fromUserId: %v - clientMsgId: %v", mg.FromUserId, mg.ClientMsgId)
+		duplicate, err := r.duplicateImage(ctx, m)
 		if err != nil {
-			r.log.Error(errors.Join(ErrJsonMarshal, err))
-			return
+			r.log.Error(err)
+		}
+		if duplicate {
+			r.RemoveMessageImage(m.ImageKey)
 		}
-		m, err := r.InsertMessage(ctx, mg.FromUserId, mg.ToUserId, mg.Content, mg.CreateTime)
+		return nil
+	}
+	// 存储成功后立即通知在线的接收者
+	if err = r.PublishEvent(ctx, &event{Message: m}); err != nil {
+		r.log.Error(err)
+	}
+	go func() {
+		ctx := context.Background()
+		key := setKey(mg.FromUserId, mg.ToUserId)
+		data, err := json.Marshal(m)
 		if err != nil {
-			r.log.Error(err)
+			r.log.Errorf("json marshal error %w", err)
 			return
 		}
-		go func() {
-			ctx = context.Background()
-			key := setKey(mg.FromUserId, mg.ToUserId)
-			data, err := json.Marshal(m)
-			if err != nil {
-				r.log.Errorf("json marshal error %w", err)
-				return
-			}
-			if err = r.data.cache.ZAdd(ctx, key, &redis.Z{
-				Score:  float64(mg.CreateTime),
-				Member: string(data),
-			}).Err(); err != nil {
-				r.log.Errorf("redis store error %w", err)
-				return
-			}
-			r.log.Info("redis store success")
-		}()
-	})
+		if err = r.data.cache.ZAdd(ctx, key, &redis.Z{
+			Score:  float64(mg.CreateTime),
+			Member: string(data),
+		}).Err(); err != nil {
+			r.log.Errorf("redis store error %w", err)
+			return
+		}
+		r.log.Info("redis store success")
+	}()
+	return nil
+}
+
+// storeGroupMessage 存储群消息并通知在线的群成员，存储失败时返回错误
+func (r *messageRepo) storeGroupMessage(ctx context.Context, mg *storeMessage) error {
+	gm := &GroupMessage{
+		GroupId:    mg.GroupId,
+		FromUserId: mg.FromUserId,
+		Content:    mg.Content,
+		CreateTime: mg.CreateTime,
+	}
+	if mg.ClientMsgId != "" {
+		gm.ClientMsgId = &mg.ClientMsgId
+	}
+	memberIds, inserted, err := insertGroupMessage(ctx, r.data.db, gm)
+	if err != nil {
+		return err
+	}
+	// 重复投递的消息已经存储并通知过
+	if !inserted {
+		r.log.Infof("duplicate group message skipped, fromUserId: %v - clientMsgId: %v", mg.FromUserId, mg.ClientMsgId)
+		return nil
+	}
+	if err = r.PublishEvent(ctx, &event{GroupMessage: gm, Recipients: memberIds}); err != nil {
+		r.log.Error(err)
+	}
+	return nil
 }
 
 // GetMessages 数据库根据最新消息时间查询消息
@@ -236,21 +440,25 @@ func (r *messageRepo) GetMessages(ctx context.Context, userId, toUserId uint32,
 	return
 }
 
-// InsertMessage 数据库插入消息
-func (r *messageRepo) InsertMessage(
-	ctx context.Context, userId uint32, toUserId uint32, content string, createTime int64,
-) (*Message, error) {
-	m := &Message{
-		FromUserId: userId,
-		ToUserId:   toUserId,
-		Content:    content,
-		CreateTime: createTime,
-	}
-	err := r.data.db.WithContext(ctx).Create(m).Error
+// InsertMessage 数据库插入消息，并在同一事务中更新会话双方的最新消息。
+// 同一发送者的客户端消息id已存在时不插入，返回是否插入了新消息
+func (r *messageRepo) InsertMessage(ctx context.Context, m *Message) (bool, error) {
+	inserted := false
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Message{}).Clauses(clause.OnConflict{DoNothing: true}).Create(m)
+		if result.Error != nil {
+			return errors.Join(ErrMysqlInsert, result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		inserted = true
+		return updateConversations(tx, m)
+	})
 	if err != nil {
-		return nil, errors.Join(ErrMysqlInsert, err)
+		return false, err
 	}
-	return m, nil
+	return inserted, nil
 }
 
 // CreateCacheByTran 缓存创建事务
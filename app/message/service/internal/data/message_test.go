@@ -0,0 +1,130 @@
+package data
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/toomanysource/atreus/pkg/sqlmockX"
+)
+
+func TestMessageRepo_DelCacheMutex(t *testing.T) {
+	ctx := context.Background()
+	key := setKey(testUserId, testToUserId)
+	repo, _ := newRedisRepo(t, key)
+
+	ok, err := repo.AddCacheMutex(ctx, key, "owner")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	ok, err = repo.AddCacheMutex(ctx, key, "other")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	// 不是锁的持有者时不删除
+	assert.Nil(t, repo.DelCacheMutex(ctx, key, "other"))
+	token, err := repo.data.cache.Get(ctx, mutexKeyPrefix+key).Result()
+	assert.Nil(t, err)
+	assert.Equal(t, "owner", token)
+	assert.Nil(t, repo.DelCacheMutex(ctx, key, "owner"))
+	_, err = repo.data.cache.Get(ctx, mutexKeyPrefix+key).Result()
+	assert.ErrorIs(t, err, redis.Nil)
+}
+
+func TestMessageRepo_RebuildCacheConcurrent(t *testing.T) {
+	ctx := context.Background()
+	key := setKey(testUserId, testToUserId)
+	repo, mock := newRedisRepo(t, key)
+	mock.ExpectQuery("FROM `message`", &sqlmockX.Rows{
+		Columns: []string{"id", "from_user_id", "to_user_id", "content", "created_at"},
+		Values:  [][]driver.Value{{int64(1), int64(testUserId), int64(testToUserId), "hello", int64(100)}},
+	})
+
+	const concurrency = 10
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ml, err := repo.getMessageList(ctx, testUserId, testToUserId, 0)
+			assert.Nil(t, err)
+			if assert.Equal(t, 1, len(ml)) {
+				assert.Equal(t, "hello", ml[0].Content)
+			}
+		}()
+	}
+	wg.Wait()
+	// 并发请求只重建一次缓存，重建后释放锁
+	assert.Equal(t, 1, len(mock.Selects("message")))
+	_, err := repo.data.cache.Get(ctx, mutexKeyPrefix+key).Result()
+	assert.ErrorIs(t, err, redis.Nil)
+}
+
+func TestMessageRepo_duplicateImage(t *testing.T) {
+	ctx := context.Background()
+	clientMsgId := "client-1"
+	m := &Message{FromUserId: 1, ToUserId: 2, ImageKey: "retry.png", ClientMsgId: &clientMsgId}
+	stored := func(key string) *sqlmockX.Rows {
+		return &sqlmockX.Rows{Columns: []string{"image_key"}, Values: [][]driver.Value{{key}}}
+	}
+
+	// 重试时上传的图片与已存储消息的图片不同
+	repo, mock := newMockMessageRepo(t)
+	mock.ExpectQuery("FROM `message`", stored("first.png"))
+	duplicate, err := repo.duplicateImage(ctx, m)
+	assert.Nil(t, err)
+	assert.True(t, duplicate)
+	selects := mock.Selects("message")
+	if assert.Len(t, selects, 1) {
+		assert.Equal(t, []driver.Value{int64(1), clientMsgId}, selects[0].Args[:2])
+	}
+
+	// Kafka重复投递同一条消息，图片已被存储的消息引用
+	repo, mock = newMockMessageRepo(t)
+	mock.ExpectQuery("FROM `message`", stored("retry.png"))
+	duplicate, err = repo.duplicateImage(ctx, m)
+	assert.Nil(t, err)
+	assert.False(t, duplicate)
+
+	// 不是图片消息时不查询
+	repo, mock = newMockMessageRepo(t)
+	duplicate, err = repo.duplicateImage(ctx, &Message{FromUserId: 1, ClientMsgId: &clientMsgId})
+	assert.Nil(t, err)
+	assert.False(t, duplicate)
+	assert.Empty(t, mock.Selects("message"))
+}
+
+func TestMessageRepo_storeMessage(t *testing.T) {
+	ctx := context.Background()
+	value := func(mg *storeMessage) []byte {
+		data, err := json.Marshal(mg)
+		assert.Nil(t, err)
+		return data
+	}
+	mg := &storeMessage{FromUserId: 1, ToUserId: 2, Content: "hello", ClientMsgId: "client-1"}
+
+	// 存储失败时返回错误，由队列重试且不提交偏移量
+	repo, mock := newMockMessageRepo(t)
+	mock.ExpectError("INSERT INTO `message`", errors.New("connection refused"))
+	assert.ErrorIs(t, repo.storeMessage(ctx, value(mg)), ErrMysqlInsert)
+
+	repo, mock = newMockMessageRepo(t)
+	mock.ExpectError("INSERT INTO `group_messages`", errors.New("connection refused"))
+	err := repo.storeMessage(ctx, value(&storeMessage{FromUserId: 1, GroupId: 3, Content: "hello"}))
+	assert.ErrorIs(t, err, ErrMysqlInsert)
+
+	// 重复投递的消息不再通知
+	repo, mock = newMockMessageRepo(t)
+	mock.ExpectExec("INSERT INTO `message`", 0)
+	assert.Nil(t, repo.storeMessage(ctx, value(mg)))
+	assert.Len(t, mock.Inserts("message"), 1)
+
+	// 无法解析的消息跳过，不再重试
+	repo, mock = newMockMessageRepo(t)
+	assert.Nil(t, repo.storeMessage(ctx, []byte("invalid")))
+	assert.Empty(t, mock.History())
+}
@@ -0,0 +1,40 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/toomanysource/atreus/api/publish/service/v1"
+	"github.com/toomanysource/atreus/app/message/service/internal/biz"
+)
+
+type publishRepo struct {
+	client pb.PublishServiceClient
+}
+
+func NewPublishRepo(conn pb.PublishServiceClient) PublishRepo {
+	return &publishRepo{
+		client: conn,
+	}
+}
+
+// GetVideos 通过Publish服务获取视频列表，不存在的视频不在结果中
+func (p *publishRepo) GetVideos(ctx context.Context, userId uint32, videoIds []uint32) ([]*biz.Video, error) {
+	resp, err := p.client.GetVideoListByVideoIds(
+		ctx, &pb.VideoListByVideoIdsRequest{UserId: userId, VideoIds: videoIds})
+	if err != nil {
+		return nil, errors.Join(ErrPublishServiceResponse, err)
+	}
+	videos := make([]*biz.Video, 0, len(resp.VideoList))
+	for _, v := range resp.VideoList {
+		if v == nil || v.Id == 0 {
+			continue
+		}
+		video := &biz.Video{Id: v.Id, Title: v.Title, PlayUrl: v.PlayUrl, CoverUrl: v.CoverUrl}
+		if v.Author != nil {
+			video.AuthorId = v.Author.Id
+		}
+		videos = append(videos, video)
+	}
+	return videos, nil
+}
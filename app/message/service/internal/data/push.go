@@ -0,0 +1,87 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/toomanysource/atreus/app/message/service/internal/biz"
+)
+
+// messagePushChannel 新消息存储后和已读位置变化后通过Redis发布订阅通知所有实例推送
+const messagePushChannel = "message:push"
+
+// event 发布订阅传递的事件，Message、Receipt和GroupMessage只有一个不为nil
+type event struct {
+	Message      *Message         `json:"message,omitempty"`
+	Receipt      *biz.ReadReceipt `json:"receipt,omitempty"`
+	GroupMessage *GroupMessage    `json:"group_message,omitempty"`
+	Recipients   []uint32         `json:"recipients,omitempty"`
+}
+
+// PublishEvent 发布事件，所有实例的订阅者都会收到
+func (r *messageRepo) PublishEvent(ctx context.Context, e *event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return errors.Join(ErrJsonMarshal, err)
+	}
+	if err = r.data.cache.Publish(ctx, messagePushChannel, data).Err(); err != nil {
+		return errors.Join(ErrRedisPublish, err)
+	}
+	return nil
+}
+
+// SubscribeEvent 订阅新消息和已读回执，阻塞直到ctx结束。
+// 新消息不在订阅循环中补全内容，由处理函数只为有在线连接的消息补全
+func (r *messageRepo) SubscribeEvent(ctx context.Context, handler func(*biz.Event)) error {
+	sub := r.data.cache.Subscribe(ctx, messagePushChannel)
+	defer sub.Close()
+	// 等待订阅确认，确保连接可用
+	if _, err := sub.Receive(ctx); err != nil {
+		return errors.Join(ErrRedisSubscribe, err)
+	}
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			e := new(event)
+			if err := json.Unmarshal([]byte(msg.Payload), e); err != nil {
+				r.log.Error(errors.Join(ErrJsonMarshal, err))
+				continue
+			}
+			if e.Receipt != nil {
+				handler(&biz.Event{Receipt: e.Receipt})
+				continue
+			}
+			if e.GroupMessage != nil {
+				handler(&biz.Event{GroupMessage: toBizGroupMessage(e.GroupMessage), Recipients: e.Recipients})
+				continue
+			}
+			if m := e.Message; m != nil {
+				msg := &biz.Message{
+					Id:             uint64(m.Id),
+					ToUserId:       m.ToUserId,
+					FromUserId:     m.FromUserId,
+					Content:        m.Content,
+					CreateTime:     m.CreateTime,
+					Recalled:       m.Recalled,
+					ContentType:    m.ContentType,
+					ImageKey:       m.ImageKey,
+					VideoId:        m.VideoId,
+					StickerId:      m.StickerId,
+					SenderKeyId:    m.SenderKeyId,
+					RecipientKeyId: m.RecipientKeyId,
+				}
+				if m.ClientMsgId != nil {
+					msg.ClientMsgId = *m.ClientMsgId
+				}
+				handler(&biz.Event{Message: msg})
+			}
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/jinzhu/copier"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/toomanysource/atreus/app/message/service/internal/biz"
+	"github.com/toomanysource/atreus/middleware"
+)
+
+// readKeyPrefix 会话已读位置缓存的key前缀，哈希表，字段为会话双方的用户id
+const readKeyPrefix = "read:"
+
+// advanceReadScript 已读位置只前进不后退，标记已读时直接写入缓存而不是删除缓存，
+// 避免与读取时回填的旧值交错。缓存不存在时创建，只包含当前用户的字段，另一方的字段在读取时回填
+var advanceReadScript = redis.NewScript(`
+local cur = tonumber(redis.call("HGET", KEYS[1], ARGV[1]) or "0")
+if tonumber(ARGV[2]) > cur then
+	redis.call("HSET", KEYS[1], ARGV[1], ARGV[2])
+end
+if redis.call("TTL", KEYS[1]) < 0 then
+	redis.call("EXPIRE", KEYS[1], ARGV[3])
+end
+return 1`)
+
+// 最新消息和未读消息数量的统计语句
+var (
+	// 参数依次为用户id、对方用户id、用户id、对方用户id、用户id、用户id，消息按创建顺序写入，
+	// 用户未删除的消息中id最大的即为最新消息
+	latestMessageQuery = "SELECT * FROM message WHERE id IN (" +
+		"SELECT MAX(id) FROM message " +
+		"WHERE ((from_user_id = ? AND to_user_id IN ?) OR (to_user_id = ? AND from_user_id IN ?)) " +
+		"AND id NOT IN (SELECT message_id FROM message_deletions WHERE user_id = ?) " +
+		"GROUP BY IF(from_user_id = ?, to_user_id, from_user_id))"
+	// 参数为用户id，对方发送的消息id大于已读位置即为未读，已撤回和用户删除的消息不计入
+	unreadCountQuery = "SELECT m.from_user_id AS id, COUNT(*) AS count FROM message m " +
+		"LEFT JOIN message_reads r ON r.user_id = m.to_user_id AND r.peer_id = m.from_user_id " +
+		"WHERE m.to_user_id = ? AND m.id > COALESCE(r.last_read_id, 0) AND " + unreadVisible +
+		"GROUP BY m.from_user_id"
+	// 参数依次为用户id、对方用户id
+	unreadCountInQuery = "SELECT m.from_user_id AS id, COUNT(*) AS count FROM message m " +
+		"LEFT JOIN message_reads r ON r.user_id = m.to_user_id AND r.peer_id = m.from_user_id " +
+		"WHERE m.to_user_id = ? AND m.from_user_id IN ? AND m.id > COALESCE(r.last_read_id, 0) AND " +
+		unreadVisible + "GROUP BY m.from_user_id"
+	// 未撤回且接收者未删除的消息
+	unreadVisible = "m.recalled = 0 AND NOT EXISTS (" +
+		"SELECT 1 FROM message_deletions d WHERE d.user_id = m.to_user_id AND d.message_id = m.id) "
+)
+
+// MessageRead 用户在与对方的会话中已读到的消息id，不大于该id的对方消息均为已读
+type MessageRead struct {
+	Id         uint32 `gorm:"primary_key"`
+	UserId     uint32 `gorm:"column:user_id;not null;uniqueIndex:uk_user_peer"`
+	PeerId     uint32 `gorm:"column:peer_id;not null;uniqueIndex:uk_user_peer"`
+	LastReadId uint64 `gorm:"column:last_read_id;not null"`
+}
+
+func (MessageRead) TableName() string {
+	return "message_reads"
+}
+
+// idCount 按id分组统计的结果
+type idCount struct {
+	Id    uint32
+	Count uint32
+}
+
+func readKey(userId, toUserId uint32) string {
+	return readKeyPrefix + setKey(userId, toUserId)
+}
+
+// MarkRead 将与toUserId的会话标记为已读到messageId，messageId为0时已读到最新消息。
+// 已读位置只前进不后退，发生变化时向对方发送已读回执
+func (r *messageRepo) MarkRead(ctx context.Context, toUserId uint32, messageId uint64) error {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	lastReadId, err := r.getReceivedId(ctx, userId, toUserId, messageId)
+	if err != nil || lastReadId == 0 {
+		return err
+	}
+	result := r.data.db.WithContext(ctx).Model(&MessageRead{}).
+		Clauses(clause.OnConflict{DoUpdates: clause.Assignments(map[string]interface{}{
+			"last_read_id": gorm.Expr("GREATEST(last_read_id, VALUES(last_read_id))"),
+		})}).
+		Create(&MessageRead{UserId: userId, PeerId: toUserId, LastReadId: lastReadId})
+	if result.Error != nil {
+		return errors.Join(ErrMysqlInsert, result.Error)
+	}
+	// 已读位置没有前进
+	if result.RowsAffected == 0 {
+		return nil
+	}
+	if err = r.AdvanceReadCache(ctx, readKey(userId, toUserId), userId, lastReadId); err != nil {
+		r.log.Error(err)
+	}
+	receipt := &biz.ReadReceipt{UserId: userId, ToUserId: toUserId, LastReadId: lastReadId}
+	if err = r.PublishEvent(ctx, &event{Receipt: receipt}); err != nil {
+		r.log.Error(err)
+	}
+	return nil
+}
+
+// getReceivedId 校验messageId是toUserId发送给userId的消息，messageId为0时返回对方发送的最新消息id，
+// 没有对方消息时返回0
+func (r *messageRepo) getReceivedId(ctx context.Context, userId, toUserId uint32, messageId uint64) (uint64, error) {
+	db := r.data.db.WithContext(ctx).Model(&Message{}).
+		Where("from_user_id = ? AND to_user_id = ?", toUserId, userId)
+	if messageId == 0 {
+		var lastId uint64
+		if err := db.Select("COALESCE(MAX(id), 0)").Scan(&lastId).Error; err != nil {
+			return 0, errors.Join(ErrMysqlQuery, err)
+		}
+		return lastId, nil
+	}
+	var count int64
+	if err := db.Where("id = ?", messageId).Count(&count).Error; err != nil {
+		return 0, errors.Join(ErrMysqlQuery, err)
+	}
+	if count == 0 {
+		return 0, ErrInvalidMessage
+	}
+	return messageId, nil
+}
+
+// GetReadCursor 获取userId在与toUserId的会话中已读到的消息id，缓存不存在时从数据库查询并创建缓存
+func (r *messageRepo) GetReadCursor(ctx context.Context, userId, toUserId uint32) (uint64, error) {
+	key := readKey(userId, toUserId)
+	value, err := r.data.cache.HGet(ctx, key, strconv.Itoa(int(userId))).Result()
+	if err == nil {
+		lastReadId, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		return lastReadId, nil
+	}
+	if !errors.Is(err, redis.Nil) {
+		return 0, errors.Join(ErrRedisQuery, err)
+	}
+	// 同时查询会话双方的已读位置
+	var reads []*MessageRead
+	err = r.data.db.WithContext(ctx).Model(&MessageRead{}).
+		Where("(user_id = ? AND peer_id = ?) OR (user_id = ? AND peer_id = ?)", userId, toUserId, toUserId, userId).
+		Find(&reads).Error
+	if err != nil {
+		return 0, errors.Join(ErrMysqlQuery, err)
+	}
+	cursors := map[uint32]uint64{userId: 0, toUserId: 0}
+	for _, v := range reads {
+		cursors[v.UserId] = v.LastReadId
+	}
+	go func() {
+		if err := r.CreateReadCache(context.Background(), key, cursors); err != nil {
+			r.log.Error(err)
+			return
+		}
+		r.log.Info("redis transaction success")
+	}()
+	return cursors[userId], nil
+}
+
+// CreateReadCache 缓存会话双方的已读位置，只写入缓存中不存在的字段，
+// 查询数据库期间标记已读写入的更新位置不会被旧值覆盖
+func (r *messageRepo) CreateReadCache(ctx context.Context, key string, cursors map[uint32]uint64) error {
+	_, err := r.data.cache.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for userId, lastReadId := range cursors {
+			if err := pipe.HSetNX(ctx, key, strconv.Itoa(int(userId)), lastReadId).Err(); err != nil {
+				return errors.Join(ErrRedisSet, err)
+			}
+		}
+		if err := pipe.Expire(ctx, key, randomTime(time.Minute, RandTimeBegin, RandTimeEnd)).Err(); err != nil {
+			return errors.Join(ErrRedisSet, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Join(ErrRedisTransaction, err)
+	}
+	return nil
+}
+
+// AdvanceReadCache 将缓存中userId的已读位置前进到lastReadId
+func (r *messageRepo) AdvanceReadCache(ctx context.Context, key string, userId uint32, lastReadId uint64) error {
+	expire := int(randomTime(time.Minute, RandTimeBegin, RandTimeEnd).Seconds())
+	err := advanceReadScript.Run(ctx, r.data.cache, []string{key}, strconv.Itoa(int(userId)), lastReadId, expire).Err()
+	if err != nil {
+		return errors.Join(ErrRedisSet, err)
+	}
+	return nil
+}
+
+// GetUnreadCounts 数据库统计用户每个会话中对方发送的未读消息数量，只返回有未读消息的会话
+func (r *messageRepo) GetUnreadCounts(ctx context.Context, userId uint32) ([]*biz.UnreadCount, error) {
+	var unread []*idCount
+	if err := r.data.db.WithContext(ctx).Raw(unreadCountQuery, userId).Scan(&unread).Error; err != nil {
+		return nil, errors.Join(ErrMysqlQuery, err)
+	}
+	counts := make([]*biz.UnreadCount, 0, len(unread))
+	for _, v := range unread {
+		counts = append(counts, &biz.UnreadCount{ToUserId: v.Id, UnreadCount: v.Count})
+	}
+	return counts, nil
+}
+
+// GetLatestMessages 数据库查询用户与toUserIds中每个用户的最新消息和对方发送的未读消息数量，结果与toUserIds一一对应
+func (r *messageRepo) GetLatestMessages(
+	ctx context.Context, userId uint32, toUserIds []uint32,
+) ([]*biz.LatestMessage, error) {
+	latest := make([]*biz.LatestMessage, 0, len(toUserIds))
+	if len(toUserIds) == 0 {
+		return latest, nil
+	}
+	messages, err := r.latestMessages(ctx, userId, toUserIds)
+	if err != nil {
+		return nil, err
+	}
+	var unread []*idCount
+	err = r.data.db.WithContext(ctx).Raw(unreadCountInQuery, userId, toUserIds).Scan(&unread).Error
+	if err != nil {
+		return nil, errors.Join(ErrMysqlQuery, err)
+	}
+	counts := make(map[uint32]uint32, len(unread))
+	for _, v := range unread {
+		counts[v.Id] = v.Count
+	}
+	for _, id := range toUserIds {
+		latest = append(latest, &biz.LatestMessage{
+			ToUserId:    id,
+			Message:     messages[id],
+			UnreadCount: counts[id],
+		})
+	}
+	return latest, nil
+}
+
+// latestMessages 数据库查询用户与toUserIds中每个用户之间用户未删除的最新消息，以对方用户id为key，
+// 撤回的消息保留撤回状态
+func (r *messageRepo) latestMessages(
+	ctx context.Context, userId uint32, toUserIds []uint32,
+) (map[uint32]*biz.Message, error) {
+	var mel []*Message
+	err := r.data.db.WithContext(ctx).
+		Raw(latestMessageQuery, userId, toUserIds, userId, toUserIds, userId, userId).Scan(&mel).Error
+	if err != nil {
+		return nil, errors.Join(ErrMysqlQuery, err)
+	}
+	messages := make(map[uint32]*biz.Message, len(mel))
+	for _, m := range mel {
+		msg := new(biz.Message)
+		if err = copier.Copy(msg, m); err != nil {
+			return nil, errors.Join(ErrCopy, err)
+		}
+		peerId := m.FromUserId
+		if peerId == userId {
+			peerId = m.ToUserId
+		}
+		messages[peerId] = msg
+	}
+	return messages, nil
+}
@@ -0,0 +1,38 @@
+package data
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/toomanysource/atreus/pkg/sqlmockX"
+)
+
+func TestMessageRepo_getReceivedId(t *testing.T) {
+	ctx := context.Background()
+
+	// messageId为0时返回对方发送的最新消息id
+	repo, mock := newMockMessageRepo(t)
+	mock.ExpectQuery("MAX(id)", &sqlmockX.Rows{Columns: []string{"max"}, Values: [][]driver.Value{{int64(5)}}})
+	id, err := repo.getReceivedId(ctx, 1, 2, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), id)
+	assert.Len(t, mock.Selects("message"), 1)
+
+	// 消息是对方发送的
+	repo, mock = newMockMessageRepo(t)
+	mock.ExpectQuery("count(*)", &sqlmockX.Rows{Columns: []string{"count"}, Values: [][]driver.Value{{int64(1)}}})
+	id, err = repo.getReceivedId(ctx, 1, 2, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), id)
+	selects := mock.Selects("message")
+	assert.Len(t, selects, 1)
+	assert.Equal(t, []driver.Value{int64(2), int64(1), int64(3)}, selects[0].Args)
+
+	// 消息不存在或不是对方发送的
+	repo, _ = newMockMessageRepo(t)
+	_, err = repo.getReceivedId(ctx, 1, 2, 3)
+	assert.ErrorIs(t, err, ErrInvalidMessage)
+}
@@ -0,0 +1,171 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/toomanysource/atreus/app/message/service/internal/biz"
+	"github.com/toomanysource/atreus/middleware"
+)
+
+// MessageDeletion 用户删除的消息，只对该用户不可见
+type MessageDeletion struct {
+	Id        uint32 `gorm:"primary_key"`
+	UserId    uint32 `gorm:"column:user_id;not null;uniqueIndex:uk_user_message"`
+	MessageId uint32 `gorm:"column:message_id;not null;uniqueIndex:uk_user_message"`
+}
+
+func (MessageDeletion) TableName() string {
+	return "message_deletions"
+}
+
+// RecallMessage 撤回自己在since之后发送的消息，双方都只能看到消息已被撤回，重复撤回视为成功
+func (r *messageRepo) RecallMessage(ctx context.Context, messageId uint64, since time.Time) error {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	m, changed, err := r.UpdateRecalled(ctx, messageId, userId, since)
+	if err != nil || !changed {
+		return err
+	}
+	// 同步更新缓存，更新失败时删除会话缓存，避免撤回的消息仍能从缓存中读到
+	if err = r.UpdateMessageCache(ctx, m); err != nil {
+		r.log.Error(err)
+		if err = r.data.cache.Del(ctx, setKey(m.FromUserId, m.ToUserId)).Err(); err != nil {
+			r.log.Error(errors.Join(ErrRedisDelete, err))
+		}
+	}
+	// 通知双方在线的设备
+	if err = r.PublishEvent(ctx, &event{Message: m}); err != nil {
+		r.log.Error(err)
+	}
+	r.log.Infof("RecallMessage -> userId: %v - messageId: %v", userId, messageId)
+	return nil
+}
+
+// UpdateRecalled 数据库将消息标记为已撤回并清空内容，图片、视频和表情一并清空，返回撤回后的消息和是否发生变化
+func (r *messageRepo) UpdateRecalled(
+	ctx context.Context, messageId uint64, userId uint32, since time.Time,
+) (*Message, bool, error) {
+	m := &Message{}
+	changed := false
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&Message{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND from_user_id = ?", messageId, userId).Take(m).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrInvalidMessage
+		}
+		if err != nil {
+			return errors.Join(ErrMysqlQuery, err)
+		}
+		if m.Recalled {
+			return nil
+		}
+		if m.CreateTime < since.UnixMilli() {
+			return biz.ErrRecallWindowExpired
+		}
+		m.Content, m.ImageKey, m.VideoId, m.StickerId, m.Recalled = "", "", 0, 0, true
+		err = tx.Model(&Message{}).Where("id = ?", m.Id).Updates(map[string]interface{}{
+			"content": m.Content, "image_key": m.ImageKey, "video_id": m.VideoId, "sticker_id": m.StickerId,
+			"recalled": m.Recalled,
+		}).Error
+		if err != nil {
+			return errors.Join(ErrMysqlUpdate, err)
+		}
+		changed = true
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return m, changed, nil
+}
+
+// UpdateMessageCache 替换聊天记录缓存中的消息，缓存中以消息创建时间为分数，只在同一分数的成员中查找
+func (r *messageRepo) UpdateMessageCache(ctx context.Context, m *Message) error {
+	key := setKey(m.FromUserId, m.ToUserId)
+	score := strconv.FormatInt(m.CreateTime, 10)
+	members, err := r.data.cache.ZRangeByScore(ctx, key, &redis.ZRangeBy{Min: score, Max: score}).Result()
+	if err != nil {
+		return errors.Join(ErrRedisQuery, err)
+	}
+	for _, member := range members {
+		cached := &Message{}
+		if err = json.Unmarshal([]byte(member), cached); err != nil {
+			return errors.Join(ErrJsonMarshal, err)
+		}
+		if cached.Id != m.Id {
+			continue
+		}
+		data, err := json.Marshal(m)
+		if err != nil {
+			return errors.Join(ErrJsonMarshal, err)
+		}
+		_, err = r.data.cache.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.ZRem(ctx, key, member)
+			pipe.ZAdd(ctx, key, &redis.Z{Score: float64(m.CreateTime), Member: string(data)})
+			return nil
+		})
+		if err != nil {
+			return errors.Join(ErrRedisTransaction, err)
+		}
+		return nil
+	}
+	return nil
+}
+
+// DeleteMessage 删除自己发送或接收的消息，只对自己不可见
+func (r *messageRepo) DeleteMessage(ctx context.Context, messageId uint64) error {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	var count int64
+	err := r.data.db.WithContext(ctx).Model(&Message{}).
+		Where("id = ? AND (from_user_id = ? OR to_user_id = ?)", messageId, userId, userId).Count(&count).Error
+	if err != nil {
+		return errors.Join(ErrMysqlQuery, err)
+	}
+	if count == 0 {
+		return ErrInvalidMessage
+	}
+	err = r.data.db.WithContext(ctx).Model(&MessageDeletion{}).Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&MessageDeletion{UserId: userId, MessageId: uint32(messageId)}).Error
+	if err != nil {
+		return errors.Join(ErrMysqlInsert, err)
+	}
+	return nil
+}
+
+// filterDeleted 过滤掉用户删除的消息
+func (r *messageRepo) filterDeleted(ctx context.Context, userId uint32, ml []*biz.Message) ([]*biz.Message, error) {
+	if len(ml) == 0 {
+		return ml, nil
+	}
+	ids := make([]uint64, 0, len(ml))
+	for _, m := range ml {
+		ids = append(ids, m.Id)
+	}
+	var deleted []uint64
+	err := r.data.db.WithContext(ctx).Model(&MessageDeletion{}).
+		Where("user_id = ? AND message_id IN ?", userId, ids).Pluck("message_id", &deleted).Error
+	if err != nil {
+		return nil, errors.Join(ErrMysqlQuery, err)
+	}
+	if len(deleted) == 0 {
+		return ml, nil
+	}
+	exclude := make(map[uint64]bool, len(deleted))
+	for _, id := range deleted {
+		exclude[id] = true
+	}
+	filtered := make([]*biz.Message, 0, len(ml)-len(deleted))
+	for _, m := range ml {
+		if !exclude[m.Id] {
+			filtered = append(filtered, m)
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,90 @@
+package data
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/toomanysource/atreus/app/message/service/internal/biz"
+	"github.com/toomanysource/atreus/middleware"
+	"github.com/toomanysource/atreus/pkg/sqlmockX"
+)
+
+func TestMessageRepo_UpdateRecalled(t *testing.T) {
+	ctx := context.Background()
+	since := time.Now().Add(-biz.DefaultRecallWindow)
+
+	repo, mock := newMockMessageRepo(t)
+	mock.ExpectQuery("FOR UPDATE", messageRows(time.Now().UnixMilli(), false))
+	m, changed, err := repo.UpdateRecalled(ctx, 1, 2, since)
+	assert.Nil(t, err)
+	assert.True(t, changed)
+	assert.True(t, m.Recalled)
+	assert.Empty(t, m.Content)
+	// 撤回时清空内容和附件
+	assert.Equal(t, []map[string]driver.Value{{
+		"content": "", "image_key": "", "video_id": int64(0), "sticker_id": int64(0), "recalled": true,
+	}}, mock.Updates("message"))
+
+	// 超过撤回时限
+	repo, mock = newMockMessageRepo(t)
+	mock.ExpectQuery("FOR UPDATE", messageRows(since.Add(-time.Second).UnixMilli(), false))
+	_, _, err = repo.UpdateRecalled(ctx, 1, 2, since)
+	assert.ErrorIs(t, err, biz.ErrRecallWindowExpired)
+	assert.Empty(t, mock.Updates("message"))
+
+	// 重复撤回视为成功，不再更新
+	repo, mock = newMockMessageRepo(t)
+	mock.ExpectQuery("FOR UPDATE", messageRows(since.Add(-time.Second).UnixMilli(), true))
+	_, changed, err = repo.UpdateRecalled(ctx, 1, 2, since)
+	assert.Nil(t, err)
+	assert.False(t, changed)
+	assert.Empty(t, mock.Updates("message"))
+
+	// 不是自己发送的消息
+	repo, mock = newMockMessageRepo(t)
+	_, _, err = repo.UpdateRecalled(ctx, 1, 2, since)
+	assert.ErrorIs(t, err, ErrInvalidMessage)
+	assert.Empty(t, mock.Updates("message"))
+}
+
+func TestMessageRepo_RecallMessage(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middleware.UserIdKey("user_id"), uint32(testUserId))
+	since := time.Now().Add(-biz.DefaultRecallWindow)
+	key := setKey(testUserId, testToUserId)
+	rows := &sqlmockX.Rows{
+		Columns: []string{"id", "from_user_id", "to_user_id", "content", "created_at"},
+		Values:  [][]driver.Value{{int64(1), int64(testUserId), int64(testToUserId), "hello", int64(time.Now().UnixMilli())}},
+	}
+	createTime := float64(rows.Values[0][4].(int64))
+
+	// 撤回后同步替换缓存中的消息
+	repo, mock := newRedisRepo(t, key)
+	mock.ExpectQuery("FOR UPDATE", rows)
+	cached, err := json.Marshal(&Message{Id: 1, FromUserId: testUserId, ToUserId: testToUserId, Content: "hello"})
+	assert.Nil(t, err)
+	repo.data.cache.ZAdd(ctx, key, &redis.Z{Score: createTime, Member: string(cached)})
+	assert.Nil(t, repo.RecallMessage(ctx, 1, since))
+	members, err := repo.data.cache.ZRange(ctx, key, 0, -1).Result()
+	assert.Nil(t, err)
+	if assert.Len(t, members, 1) {
+		m := &Message{}
+		assert.Nil(t, json.Unmarshal([]byte(members[0]), m))
+		assert.True(t, m.Recalled)
+		assert.Empty(t, m.Content)
+	}
+
+	// 缓存更新失败时删除会话缓存
+	repo, mock = newRedisRepo(t, key)
+	mock.ExpectQuery("FOR UPDATE", rows)
+	repo.data.cache.ZAdd(ctx, key, &redis.Z{Score: createTime, Member: "invalid"})
+	assert.Nil(t, repo.RecallMessage(ctx, 1, since))
+	exists, err := repo.data.cache.Exists(ctx, key).Result()
+	assert.Nil(t, err)
+	assert.Zero(t, exists)
+}
@@ -0,0 +1,30 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/toomanysource/atreus/api/relation/service/v1"
+)
+
+type relationRepo struct {
+	client pb.RelationServiceClient
+}
+
+func NewRelationRepo(conn pb.RelationServiceClient) RelationRepo {
+	return &relationRepo{
+		client: conn,
+	}
+}
+
+// IsBlockedByReceiver 通过Relation服务判断消息接收者是否屏蔽了发送者
+func (r *relationRepo) IsBlockedByReceiver(ctx context.Context, fromUserId, toUserId uint32) (bool, error) {
+	resp, err := r.client.IsBlocked(ctx, &pb.IsBlockedRequest{UserId: fromUserId, ToUserId: []uint32{toUserId}})
+	if err != nil {
+		return false, errors.Join(ErrRelationServiceResponse, err)
+	}
+	if len(resp.IsBlocked) == 0 {
+		return false, ErrRelationServiceResponse
+	}
+	return resp.IsBlocked[0], nil
+}
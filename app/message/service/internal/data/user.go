@@ -0,0 +1,39 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/toomanysource/atreus/api/user/service/v1"
+)
+
+type userRepo struct {
+	client pb.UserServiceClient
+}
+
+func NewUserRepo(conn pb.UserServiceClient) UserRepo {
+	return &userRepo{
+		client: conn,
+	}
+}
+
+// CheckKeys 通过User服务校验加密消息使用的公钥，发送者公钥必须属于发送者，
+// 接收者公钥必须是接收者的当前公钥，对方轮换公钥后发送者需要重新获取
+func (u *userRepo) CheckKeys(ctx context.Context, userId, toUserId, senderKeyId, recipientKeyId uint32) error {
+	resp, err := u.client.GetPublicKeys(ctx, &pb.PublicKeysRequest{KeyIds: []uint32{senderKeyId, recipientKeyId}})
+	if err != nil {
+		return errors.Join(ErrUserServiceResponse, err)
+	}
+	keys := make(map[uint32]*pb.PublicKey, len(resp.Keys))
+	for _, k := range resp.Keys {
+		keys[k.Id] = k
+	}
+	sender, recipient := keys[senderKeyId], keys[recipientKeyId]
+	if sender == nil || sender.UserId != userId || recipient == nil || recipient.UserId != toUserId {
+		return ErrInvalidKey
+	}
+	if !recipient.IsCurrent {
+		return ErrKeyRotated
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package server
 
 import (
+	nethttp "net/http"
+
 	"github.com/go-kratos/kratos/v2/middleware/validate"
 
 	v1 "github.com/toomanysource/atreus/api/message/service/v1"
@@ -40,5 +42,16 @@ func NewHTTPServer(c *conf.Server, t *conf.JWT, greeter *service.MessageService,
 	}
 	srv := http.NewServer(opts...)
 	v1.RegisterMessageServiceHTTPServer(srv, greeter)
+	// WebSocket连接不经过中间件，需要单独解析token
+	srv.HandleFunc("/douyin/message/ws", func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		userId, err := middleware.ParseToken(r.URL.Query().Get("token"), func(token *jwt.Token) (interface{}, error) {
+			return []byte(t.Http.TokenKey), nil
+		})
+		if err != nil {
+			nethttp.Error(w, err.Error(), nethttp.StatusUnauthorized)
+			return
+		}
+		greeter.Connect(w, r, userId)
+	})
 	return srv
 }
@@ -1,17 +1,96 @@
 package server
 
 import (
+	"context"
+
 	"github.com/google/wire"
 
 	"github.com/toomanysource/atreus/app/message/service/internal/conf"
 
 	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/logging"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/hashicorp/consul/api"
+
+	publishv1 "github.com/toomanysource/atreus/api/publish/service/v1"
+	relationv1 "github.com/toomanysource/atreus/api/relation/service/v1"
+	userv1 "github.com/toomanysource/atreus/api/user/service/v1"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewRegistrar)
+var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewRelationClient, NewPublishClient, NewUserClient, NewDiscovery, NewRegistrar)
+
+// NewRelationClient 创建一个Relation服务客户端，接收Relation服务数据
+func NewRelationClient(r registry.Discovery, logger log.Logger) relationv1.RelationServiceClient {
+	logs := log.NewHelper(log.With(logger, "module", "server/relation"))
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint("discovery:///atreus.relation.service"),
+		grpc.WithDiscovery(r),
+		grpc.WithMiddleware(
+			recovery.Recovery(),
+			logging.Client(logger),
+		),
+	)
+	if err != nil {
+		logs.Fatalf("relation service connect error, %v", err)
+	}
+	logs.Info("relation service connect successfully")
+	return relationv1.NewRelationServiceClient(conn)
+}
+
+// NewPublishClient 创建一个Publish服务客户端，接收Publish服务数据
+func NewPublishClient(r registry.Discovery, logger log.Logger) publishv1.PublishServiceClient {
+	logs := log.NewHelper(log.With(logger, "module", "server/publish"))
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint("discovery:///atreus.publish.service"),
+		grpc.WithDiscovery(r),
+		grpc.WithMiddleware(
+			recovery.Recovery(),
+			logging.Client(logger),
+		),
+	)
+	if err != nil {
+		logs.Fatalf("publish service connect error, %v", err)
+	}
+	logs.Info("publish service connect successfully")
+	return publishv1.NewPublishServiceClient(conn)
+}
+
+// NewUserClient 创建一个User服务客户端，接收User服务数据
+func NewUserClient(r registry.Discovery, logger log.Logger) userv1.UserServiceClient {
+	logs := log.NewHelper(log.With(logger, "module", "server/user"))
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint("discovery:///atreus.user.service"),
+		grpc.WithDiscovery(r),
+		grpc.WithMiddleware(
+			recovery.Recovery(),
+			logging.Client(logger),
+		),
+	)
+	if err != nil {
+		logs.Fatalf("user service connect error, %v", err)
+	}
+	logs.Info("user service connect successfully")
+	return userv1.NewUserServiceClient(conn)
+}
+
+func NewDiscovery(conf *conf.Registry) registry.Discovery {
+	c := api.DefaultConfig()
+	c.Address = conf.Consul.Address
+	c.Scheme = conf.Consul.Scheme
+	cli, err := api.NewClient(c)
+	if err != nil {
+		panic(err)
+	}
+	r := consul.New(cli, consul.WithHealthCheck(false))
+	return r
+}
 
 func NewRegistrar(conf *conf.Registry) registry.Registrar {
 	c := api.DefaultConfig()
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jinzhu/copier"
+
+	pb "github.com/toomanysource/atreus/api/message/service/v1"
+)
+
+// CreateGroup 创建群聊
+func (s *MessageService) CreateGroup(ctx context.Context, req *pb.CreateGroupRequest) (*pb.CreateGroupReply, error) {
+	reply := &pb.CreateGroupReply{StatusCode: CodeSuccess, StatusMsg: "success"}
+	group, err := s.gu.CreateGroup(ctx, req.Name, req.MemberIds)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	reply.Group = new(pb.Group)
+	if err = copier.Copy(reply.Group, group); err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	return reply, nil
+}
+
+// RenameGroup 修改群名称
+func (s *MessageService) RenameGroup(ctx context.Context, req *pb.RenameGroupRequest) (*pb.GroupActionReply, error) {
+	return groupActionReply(s.gu.RenameGroup(ctx, req.GroupId, req.Name)), nil
+}
+
+// AddGroupMembers 邀请用户加入群聊
+func (s *MessageService) AddGroupMembers(
+	ctx context.Context, req *pb.AddGroupMembersRequest,
+) (*pb.GroupActionReply, error) {
+	return groupActionReply(s.gu.AddGroupMembers(ctx, req.GroupId, req.UserIds)), nil
+}
+
+// RemoveGroupMember 将用户移出群聊
+func (s *MessageService) RemoveGroupMember(
+	ctx context.Context, req *pb.RemoveGroupMemberRequest,
+) (*pb.GroupActionReply, error) {
+	return groupActionReply(s.gu.RemoveGroupMember(ctx, req.GroupId, req.UserId)), nil
+}
+
+// LeaveGroup 退出群聊
+func (s *MessageService) LeaveGroup(ctx context.Context, req *pb.LeaveGroupRequest) (*pb.GroupActionReply, error) {
+	return groupActionReply(s.gu.LeaveGroup(ctx, req.GroupId)), nil
+}
+
+// GroupMessageAction 发送群消息
+func (s *MessageService) GroupMessageAction(
+	ctx context.Context, req *pb.GroupMessageActionRequest,
+) (*pb.GroupActionReply, error) {
+	return groupActionReply(s.gu.SendGroupMessage(ctx, req.GroupId, req.Content, req.ClientMsgId)), nil
+}
+
+// GetGroupMessageList 获取群消息列表
+func (s *MessageService) GetGroupMessageList(
+	ctx context.Context, req *pb.GroupMessageListRequest,
+) (*pb.GroupMessageListReply, error) {
+	reply := &pb.GroupMessageListReply{
+		StatusCode: CodeSuccess, StatusMsg: "success", MessageList: make([]*pb.GroupMessage, 0),
+	}
+	messages, next, err := s.gu.GetGroupMessages(ctx, req.GroupId, req.Cursor, req.Count)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	if err = copier.Copy(&reply.MessageList, &messages); err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	reply.NextCursor = next
+	return reply, nil
+}
+
+// groupActionReply 根据群聊操作的结果生成回复
+func groupActionReply(err error) *pb.GroupActionReply {
+	if err != nil {
+		return &pb.GroupActionReply{StatusCode: CodeFailed, StatusMsg: err.Error()}
+	}
+	return &pb.GroupActionReply{StatusCode: CodeSuccess, StatusMsg: "success"}
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	writeWait      = 10 * time.Second  // 单次写入的超时时间
+	pongWait       = 60 * time.Second  // 等待客户端pong的超时时间
+	pingPeriod     = pongWait * 9 / 10 // 发送ping的间隔，需小于pongWait
+	sendBufferSize = 64                // 每个连接待推送消息的缓冲数量
+	maxMessageSize = 512               // 客户端发送的消息大小上限，连接只用于推送
+)
+
+// client 一个用户的一条WebSocket连接
+type client struct {
+	userId uint32
+	conn   *websocket.Conn
+	send   chan []byte
+}
+
+// Hub 本实例的WebSocket连接注册表，同一用户可以有多条连接
+type Hub struct {
+	mu      sync.RWMutex
+	clients map[uint32]map[*client]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{clients: make(map[uint32]map[*client]struct{})}
+}
+
+// register 注册连接
+func (h *Hub) register(c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.clients[c.userId] == nil {
+		h.clients[c.userId] = make(map[*client]struct{})
+	}
+	h.clients[c.userId][c] = struct{}{}
+}
+
+// unregister 注销连接并关闭推送通道，重复注销无影响
+func (h *Hub) unregister(c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	conns, ok := h.clients[c.userId]
+	if !ok {
+		return
+	}
+	if _, ok = conns[c]; !ok {
+		return
+	}
+	delete(conns, c)
+	close(c.send)
+	if len(conns) == 0 {
+		delete(h.clients, c.userId)
+	}
+}
+
+// Push 向用户在本实例上的所有连接推送数据，缓冲已满的慢连接会被断开
+func (h *Hub) Push(userId uint32, data []byte) {
+	h.mu.RLock()
+	var slow []*client
+	for c := range h.clients[userId] {
+		select {
+		case c.send <- data:
+		default:
+			slow = append(slow, c)
+		}
+	}
+	h.mu.RUnlock()
+	for _, c := range slow {
+		h.unregister(c)
+	}
+}
+
+// Online 用户在本实例上的连接数量
+func (h *Hub) Online(userId uint32) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.clients[userId])
+}
+
+// writePump 将推送通道中的数据写入连接，并定时发送ping保活
+func (h *Hub) writePump(c *client) {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		_ = c.conn.Close()
+	}()
+	for {
+		select {
+		case data, ok := <-c.send:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				_ = c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+				return
+			}
+			if err := c.conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				return
+			}
+		case <-ticker.C:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// readPump 读取连接以处理pong和关闭帧，连接断开时注销
+func (h *Hub) readPump(c *client) {
+	defer func() {
+		h.unregister(c)
+		_ = c.conn.Close()
+	}()
+	c.conn.SetReadLimit(maxMessageSize)
+	_ = c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+	for {
+		if _, _, err := c.conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(userId uint32, size int) *client {
+	return &client{userId: userId, send: make(chan []byte, size)}
+}
+
+func TestHub_RegisterUnregister(t *testing.T) {
+	h := NewHub()
+	c1, c2 := newTestClient(1, 1), newTestClient(1, 1)
+	h.register(c1)
+	h.register(c2)
+	h.register(newTestClient(2, 1))
+	assert.Equal(t, 2, h.Online(1))
+	assert.Equal(t, 1, h.Online(2))
+
+	h.unregister(c1)
+	assert.Equal(t, 1, h.Online(1))
+	_, ok := <-c1.send
+	assert.False(t, ok)
+	// 重复注销不会再次关闭通道
+	h.unregister(c1)
+	h.unregister(c2)
+	assert.Equal(t, 0, h.Online(1))
+	assert.NotContains(t, h.clients, uint32(1))
+}
+
+func TestHub_PushEvictsSlowClient(t *testing.T) {
+	h := NewHub()
+	fast, slow := newTestClient(1, 2), newTestClient(1, 1)
+	h.register(fast)
+	h.register(slow)
+	h.Push(1, []byte("a"))
+	h.Push(1, []byte("b"))
+	// 缓冲已满的连接被断开，其他连接不受影响
+	assert.Equal(t, 1, h.Online(1))
+	assert.Equal(t, "a", string(<-fast.send))
+	assert.Equal(t, "b", string(<-fast.send))
+	assert.Equal(t, "a", string(<-slow.send))
+	_, ok := <-slow.send
+	assert.False(t, ok)
+	// 没有连接的用户推送无影响
+	h.Push(2, []byte("c"))
+}
+
+func TestHub_Pumps(t *testing.T) {
+	h := NewHub()
+	registered := make(chan *client, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		c := &client{userId: 1, conn: conn, send: make(chan []byte, sendBufferSize)}
+		h.register(c)
+		go h.writePump(c)
+		go h.readPump(c)
+		registered <- c
+	}))
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	// 推送的数据由writePump写入连接
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := <-registered
+	h.Push(1, []byte("hello"))
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	typ, data, err := conn.ReadMessage()
+	assert.Nil(t, err)
+	assert.Equal(t, websocket.TextMessage, typ)
+	assert.Equal(t, "hello", string(data))
+
+	// 注销后writePump发送关闭帧
+	h.unregister(c)
+	_, _, err = conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseNoStatusReceived))
+	_ = conn.Close()
+
+	// 客户端断开后readPump注销连接
+	conn, _, err = websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-registered
+	assert.Equal(t, 1, h.Online(1))
+	_ = conn.Close()
+	assert.Eventually(t, func() bool { return h.Online(1) == 0 }, time.Second, 10*time.Millisecond)
+}
@@ -2,7 +2,11 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/jinzhu/copier"
 
 	pb "github.com/toomanysource/atreus/api/message/service/v1"
@@ -11,22 +15,118 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 )
 
+// 重新订阅推送事件的退避时间
+const (
+	subscribeMinBackoff = time.Second
+	subscribeMaxBackoff = time.Minute
+)
+
 type MessageService struct {
 	pb.UnimplementedMessageServiceServer
-	mu  *biz.MessageUseCase
-	log *log.Helper
+	mu       *biz.MessageUseCase
+	gu       *biz.GroupUseCase
+	hub      *Hub
+	upgrader websocket.Upgrader
+	log      *log.Helper
 }
 
-func NewMessageService(mu *biz.MessageUseCase, logger log.Logger) *MessageService {
-	return &MessageService{
+func NewMessageService(mu *biz.MessageUseCase, gu *biz.GroupUseCase, logger log.Logger) *MessageService {
+	s := &MessageService{
 		mu:  mu,
+		gu:  gu,
+		hub: NewHub(),
+		upgrader: websocket.Upgrader{
+			// 鉴权由token完成，允许任意来源
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
 		log: log.NewHelper(log.With(logger, "model", "service/message")),
 	}
+	go s.subscribe(context.Background())
+	return s
+}
+
+// subscribe 订阅事件并推送给本实例的连接，订阅中断后按指数退避重新订阅，直到ctx结束
+func (s *MessageService) subscribe(ctx context.Context) {
+	backoff := subscribeMinBackoff
+	for {
+		start := time.Now()
+		err := s.mu.SubscribeEvent(ctx, s.deliver)
+		if ctx.Err() != nil {
+			return
+		}
+		// 订阅正常运行一段时间后才中断，退避时间从头计算
+		if time.Since(start) > subscribeMaxBackoff {
+			backoff = subscribeMinBackoff
+		}
+		s.log.Errorf("message push subscription stopped, retry in %v: %v", backoff, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > subscribeMaxBackoff {
+			backoff = subscribeMaxBackoff
+		}
+	}
+}
+
+// Connect 将已鉴权的请求升级为WebSocket连接，新消息存储后实时推送给连接
+func (s *MessageService) Connect(w http.ResponseWriter, r *http.Request, userId uint32) {
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		s.log.Errorf("websocket upgrade error: %v", err)
+		return
+	}
+	c := &client{userId: userId, conn: conn, send: make(chan []byte, sendBufferSize)}
+	s.hub.register(c)
+	go s.hub.writePump(c)
+	go s.hub.readPump(c)
+}
+
+// deliver 将事件推送给相关用户在本实例上的连接。新消息推送给接收者和发送者的其他设备，
+// 已读回执推送给消息发送者和读取者的其他设备，群消息推送给全部群成员
+func (s *MessageService) deliver(e *biz.Event) {
+	var userIds []uint32
+	switch {
+	case e.Receipt != nil:
+		userIds = []uint32{e.Receipt.ToUserId, e.Receipt.UserId}
+	case e.GroupMessage != nil:
+		userIds = e.Recipients
+	default:
+		userIds = []uint32{e.Message.ToUserId, e.Message.FromUserId}
+	}
+	online := false
+	for _, userId := range userIds {
+		if s.hub.Online(userId) > 0 {
+			online = true
+			break
+		}
+	}
+	if !online {
+		return
+	}
+	// 只为有在线连接的新消息补全内容，避免为每条消息签名图片地址和调用Publish服务
+	if e.Message != nil {
+		s.mu.AttachContents(context.Background(), e.Message)
+	}
+	pe := &pb.PushEvent{Type: e.Type()}
+	if err := copier.Copy(pe, e); err != nil {
+		s.log.Errorf("copy event error: %v", err)
+		return
+	}
+	data, err := json.Marshal(pe)
+	if err != nil {
+		s.log.Errorf("json marshal error: %v", err)
+		return
+	}
+	for _, userId := range userIds {
+		s.hub.Push(userId, data)
+	}
 }
 
 func (s *MessageService) GetMessageList(ctx context.Context, req *pb.MessageListRequest) (*pb.MessageListReply, error) {
 	reply := &pb.MessageListReply{StatusCode: CodeSuccess, StatusMsg: "success", MessageList: make([]*pb.Message, 0)}
-	message, err := s.mu.GetMessageList(ctx, req.ToUserId, req.PreMsgTime)
+	message, peerLastReadId, err := s.mu.GetMessageList(ctx, req.ToUserId, req.PreMsgTime)
 	if err != nil {
 		reply.StatusCode = CodeFailed
 		reply.StatusMsg = err.Error()
@@ -40,16 +140,97 @@ func (s *MessageService) GetMessageList(ctx context.Context, req *pb.MessageList
 		return reply, nil
 	}
 	reply.MessageList = ml
+	reply.PeerLastReadId = peerLastReadId
+	return reply, nil
+}
+
+// ListConversations 获取会话列表
+func (s *MessageService) ListConversations(
+	ctx context.Context, req *pb.ConversationListRequest,
+) (*pb.ConversationListReply, error) {
+	reply := &pb.ConversationListReply{
+		StatusCode: CodeSuccess, StatusMsg: "success", ConversationList: make([]*pb.Conversation, 0),
+	}
+	conversations, next, err := s.mu.ListConversations(ctx, req.Cursor, req.Count)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	if err = copier.Copy(&reply.ConversationList, &conversations); err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	reply.NextCursor = next
+	return reply, nil
+}
+
+// MarkRead 标记已读
+func (s *MessageService) MarkRead(ctx context.Context, req *pb.MarkReadRequest) (*pb.MarkReadReply, error) {
+	reply := &pb.MarkReadReply{StatusCode: CodeSuccess, StatusMsg: "success"}
+	if err := s.mu.MarkRead(ctx, req.ToUserId, req.MessageId); err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	return reply, nil
+}
+
+// GetUnreadCount 获取未读消息数量
+func (s *MessageService) GetUnreadCount(ctx context.Context, req *pb.UnreadCountRequest) (*pb.UnreadCountReply, error) {
+	reply := &pb.UnreadCountReply{
+		StatusCode: CodeSuccess, StatusMsg: "success", Conversations: make([]*pb.UnreadConversation, 0),
+	}
+	counts, total, err := s.mu.GetUnreadCount(ctx)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	if err = copier.Copy(&reply.Conversations, &counts); err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	reply.TotalCount = total
+	return reply, nil
+}
+
+func (s *MessageService) GetLatestMessages(
+	ctx context.Context, req *pb.LatestMessagesRequest,
+) (*pb.LatestMessagesReply, error) {
+	latest, err := s.mu.GetLatestMessages(ctx, req.UserId, req.ToUserIds)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.LatestMessagesReply{Messages: make([]*pb.LatestMessage, 0, len(latest))}
+	if err = copier.Copy(&reply.Messages, &latest); err != nil {
+		return nil, err
+	}
 	return reply, nil
 }
 
 func (s *MessageService) MessageAction(ctx context.Context, req *pb.MessageActionRequest) (*pb.MessageActionReply, error) {
 	reply := &pb.MessageActionReply{StatusCode: CodeSuccess, StatusMsg: "success"}
-	err := s.mu.PublishMessage(ctx, req.ToUserId, req.ActionType, req.Content)
+	payload := &biz.Payload{
+		ContentType:    req.ContentType,
+		Content:        req.Content,
+		ImageData:      req.ImageData,
+		VideoId:        req.VideoId,
+		StickerId:      req.StickerId,
+		ClientMsgId:    req.ClientMsgId,
+		SenderKeyId:    req.SenderKeyId,
+		RecipientKeyId: req.RecipientKeyId,
+	}
+	err := s.mu.MessageAction(ctx, req.ToUserId, req.ActionType, req.MessageId, payload)
 	if err != nil {
 		reply.StatusCode = CodeFailed
 		reply.StatusMsg = err.Error()
 		return reply, nil
 	}
+	if req.ActionType == biz.PublishMessage {
+		reply.ClientMsgId = payload.ClientMsgId
+	}
 	return reply, nil
 }
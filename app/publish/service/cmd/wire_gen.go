@@ -36,7 +36,8 @@ func wireApp(confServer *conf.Server, registry *conf.Registry, minio *conf.Minio
 	discovery := server.NewDiscovery(registry)
 	userServiceClient := server.NewUserClient(discovery, logger)
 	favoriteServiceClient := server.NewFavoriteClient(discovery, logger)
-	publishRepo := data.NewPublishRepo(dataData, userServiceClient, favoriteServiceClient, logger)
+	relationServiceClient := server.NewRelationClient(discovery, logger)
+	publishRepo := data.NewPublishRepo(dataData, userServiceClient, favoriteServiceClient, relationServiceClient, logger)
 	publishUseCase := biz.NewPublishUseCase(publishRepo, logger)
 	publishService := service.NewPublishService(publishUseCase, logger)
 	grpcServer := server.NewGRPCServer(confServer, publishService, logger)
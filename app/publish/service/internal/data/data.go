@@ -9,6 +9,7 @@ import (
 
 	"github.com/toomanysource/atreus/app/publish/service/internal/conf"
 	"github.com/toomanysource/atreus/pkg/minioX"
+	"github.com/toomanysource/atreus/pkg/outboxX"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
@@ -32,6 +33,7 @@ var (
 	ErrFileWrite               = errors.New("file write error")
 	ErrMysqlUpdate             = errors.New("mysql update error")
 	ErrFavoriteServiceResponse = errors.New("favorite service response error")
+	ErrRelationServiceResponse = errors.New("relation service response error")
 )
 
 type KfkReader struct {
@@ -49,7 +51,12 @@ type Data struct {
 
 func NewData(db *gorm.DB, minioClient *minioX.Client, kfkWriter *kafka.Writer, kfkReader KfkReader, logger log.Logger) (*Data, func(), error) {
 	logHelper := log.NewHelper(log.With(logger, "module", "data/data"))
+	// 发件箱中的作品数变更事件由relay投递到Kafka
+	relay := outboxX.NewRelay(db, logger, kfkWriter)
+	relay.Start()
 	cleanup := func() {
+		// 先停止投递再关闭Kafka连接
+		relay.Stop()
 		var wg sync.WaitGroup
 		wg.Add(1)
 		go func() {
@@ -171,4 +178,7 @@ func InitDB(db *gorm.DB) {
 	if err := db.AutoMigrate(&Video{}); err != nil {
 		log.Fatalf("database initialization error, err : %v", err)
 	}
+	if err := outboxX.InitDB(db); err != nil {
+		log.Fatalf("outbox initialization error, err : %v", err)
+	}
 }
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	favoritev1 "github.com/toomanysource/atreus/api/favorite/service/v1"
+	relationv1 "github.com/toomanysource/atreus/api/relation/service/v1"
 	userv1 "github.com/toomanysource/atreus/api/user/service/v1"
 
 	"github.com/toomanysource/atreus/middleware"
@@ -20,12 +21,14 @@ import (
 	"github.com/segmentio/kafka-go"
 
 	"github.com/toomanysource/atreus/pkg/kafkaX"
+	"github.com/toomanysource/atreus/pkg/outboxX"
 
 	"github.com/toomanysource/atreus/app/publish/service/internal/biz"
 	"github.com/toomanysource/atreus/pkg/ffmpegX"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/minio/minio-go/v7"
+	"gorm.io/gorm"
 )
 
 var ErrVideoMissing = errors.New("video missing")
@@ -54,22 +57,29 @@ type FavoriteRepo interface {
 	IsFavorite(context.Context, uint32, []uint32) ([]bool, error)
 }
 
+type RelationRepo interface {
+	GetMuteList(context.Context, uint32) ([]uint32, error)
+}
+
 type publishRepo struct {
 	data         *Data
 	kfk          KfkReader
 	favoriteRepo FavoriteRepo
 	userRepo     UserRepo
+	relationRepo RelationRepo
 	log          *log.Helper
 }
 
 func NewPublishRepo(
-	data *Data, userConn userv1.UserServiceClient, favoriteConn favoritev1.FavoriteServiceClient, logger log.Logger,
+	data *Data, userConn userv1.UserServiceClient, favoriteConn favoritev1.FavoriteServiceClient,
+	relationConn relationv1.RelationServiceClient, logger log.Logger,
 ) biz.PublishRepo {
 	return &publishRepo{
 		data:         data,
 		kfk:          data.kfkReader,
 		favoriteRepo: NewFavoriteRepo(favoriteConn),
 		userRepo:     NewUserRepo(userConn),
+		relationRepo: NewRelationRepo(relationConn),
 		log:          log.NewHelper(log.With(logger, "module", "data/publish")),
 	}
 }
@@ -111,11 +121,6 @@ func (r *publishRepo) UploadAll(ctx context.Context, fileBytes []byte, title str
 				r.log.Error(err)
 				return
 			}
-			err = kafkaX.Update(r.data.kfkWriter, strconv.Itoa(int(userId)), "1")
-			if err != nil {
-				r.log.Error(err)
-				return
-			}
 		}()
 	}
 	return nil
@@ -133,7 +138,15 @@ func (r *publishRepo) GetFeedList(
 	if err != nil {
 		return 0, nil, err
 	}
-	videoList, err := r.GetVideoByTime(ctx, int64(times))
+	// 登录用户的视频流中不出现被其静音的用户的视频
+	var muteIds []uint32
+	if userId != 0 {
+		muteIds, err = r.relationRepo.GetMuteList(ctx, userId)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	videoList, err := r.GetVideoByTime(ctx, int64(times), muteIds)
 	if err != nil {
 		return 0, nil, err
 	}
@@ -249,10 +262,13 @@ func (r *publishRepo) SaveVideoInfo(ctx context.Context, title string, userId ui
 		CommentCount:  0,
 		CreatedAt:     time.Now().UnixMilli(),
 	}
-	if err = r.data.db.WithContext(ctx).Create(v).Error; err != nil {
-		return errors.Join(ErrMysqlInsert, err)
-	}
-	return nil
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(v).Error; err != nil {
+			return errors.Join(ErrMysqlInsert, err)
+		}
+		// 作品数变更事件与视频信息在同一事务中写入发件箱
+		return outboxX.Add(tx, r.data.kfkWriter.Topic, strconv.Itoa(int(userId)), "1")
+	})
 }
 
 // GetRemoteVideoInfo 获取远程视频及封面url
@@ -316,11 +332,14 @@ func (r *publishRepo) GenerateCoverImage(fileBytes []byte) (io.Reader, error) {
 	return ffmpegX.ReadFrameAsImage(tempFile.Name(), FrameNumber)
 }
 
-// GetVideoByTime 根据时间获取视频列表
-func (r *publishRepo) GetVideoByTime(ctx context.Context, times int64) ([]*Video, error) {
+// GetVideoByTime 根据时间获取视频列表，排除excludeIds中作者的视频
+func (r *publishRepo) GetVideoByTime(ctx context.Context, times int64, excludeIds []uint32) ([]*Video, error) {
 	var videoList []*Video
-	err := r.data.db.WithContext(ctx).Where("created_at < ?", times).
-		Order("created_at desc").Limit(VideoCount).Find(&videoList).Error
+	db := r.data.db.WithContext(ctx).Where("created_at < ?", times)
+	if len(excludeIds) != 0 {
+		db = db.Where("author_id NOT IN ?", excludeIds)
+	}
+	err := db.Order("created_at desc").Limit(VideoCount).Find(&videoList).Error
 	if err != nil {
 		return nil, errors.Join(ErrMysqlQuery, err)
 	}
@@ -446,43 +465,47 @@ func (r *publishRepo) UpdateDatabaseUrl(ctx context.Context, videoId uint32, pla
 
 // InitUpdateFavoriteQueue 初始化更新点赞数队列
 func (r *publishRepo) InitUpdateFavoriteQueue() {
-	kafkaX.Reader(r.kfk.favorite, r.log, func(ctx context.Context, reader *kafka.Reader, msg kafka.Message) {
+	kafkaX.Reader(r.kfk.favorite, r.log, func(ctx context.Context, reader *kafka.Reader, msg kafka.Message) error {
 		videoId, err := strconv.Atoi(string(msg.Key))
 		if err != nil {
 			r.log.Error(ErrKafkaReader, err)
-			return
+			return nil
 		}
 		change, err := strconv.Atoi(string(msg.Value))
 		if err != nil {
 			r.log.Error(ErrKafkaReader, err)
-			return
+			return nil
 		}
 		err = r.UpdateFavoriteCount(ctx, uint32(videoId), int32(change))
-		if err != nil {
+		// 视频不存在时重试没有意义，跳过该消息
+		if errors.Is(err, gorm.ErrRecordNotFound) {
 			r.log.Error(ErrKafkaReader, err)
-			return
+			return nil
 		}
+		return err
 	})
 }
 
 // InitUpdateCommentQueue 初始化更新评论数队列
 func (r *publishRepo) InitUpdateCommentQueue() {
-	kafkaX.Reader(r.kfk.comment, r.log, func(ctx context.Context, reader *kafka.Reader, msg kafka.Message) {
+	kafkaX.Reader(r.kfk.comment, r.log, func(ctx context.Context, reader *kafka.Reader, msg kafka.Message) error {
 		videoId, err := strconv.Atoi(string(msg.Key))
 		if err != nil {
 			r.log.Error(ErrKafkaReader, err)
-			return
+			return nil
 		}
 		change, err := strconv.Atoi(string(msg.Value))
 		if err != nil {
 			r.log.Error(ErrKafkaReader, err)
-			return
+			return nil
 		}
 		err = r.UpdateCommentCount(ctx, uint32(videoId), int32(change))
-		if err != nil {
+		// 视频不存在时重试没有意义，跳过该消息
+		if errors.Is(err, gorm.ErrRecordNotFound) {
 			r.log.Error(ErrKafkaReader, err)
-			return
+			return nil
 		}
+		return err
 	})
 }
 
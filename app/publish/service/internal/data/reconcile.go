@@ -0,0 +1,121 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReconcileBatchSize 每批校准的视频数量
+const ReconcileBatchSize = 500
+
+// ReconcileResult 计数校准结果
+type ReconcileResult struct {
+	Scanned int64 // 检查的视频数量
+	Changed int64 // 计数存在偏差并被修复的视频数量
+}
+
+// videoCounter 视频表中由Kafka增量维护的计数字段
+type videoCounter struct {
+	Id            uint32
+	FavoriteCount uint32
+	CommentCount  uint32
+}
+
+// idCount 按id分组统计的结果
+type idCount struct {
+	Id    uint32
+	Count uint32
+}
+
+// counterQueries 各计数字段在源数据表上的统计语句，参数为本批视频id
+var counterQueries = map[string]string{
+	"favorite_count": "SELECT video_id AS id, COUNT(*) AS count FROM favorites WHERE video_id IN ? GROUP BY video_id",
+	"comment_count":  "SELECT video_id AS id, COUNT(*) AS count FROM comments WHERE video_id IN ? GROUP BY video_id",
+}
+
+// ReconcileVideoCounters 根据favorites和comments表分批重新计算视频的点赞数和评论数，只更新存在偏差的视频。
+// 校准期间仍在发件箱或Kafka中未消费的增量事件会在校准后再次生效，定期执行可以消除这部分偏差
+func ReconcileVideoCounters(ctx context.Context, db *gorm.DB, batchSize int) (ReconcileResult, error) {
+	var result ReconcileResult
+	var lastId uint32
+	for {
+		var ids []uint32
+		err := db.WithContext(ctx).Model(&Video{}).
+			Where("id > ?", lastId).Order("id").Limit(batchSize).Pluck("id", &ids).Error
+		if err != nil {
+			return result, errors.Join(ErrMysqlQuery, err)
+		}
+		if len(ids) == 0 {
+			return result, nil
+		}
+		changed, err := reconcileVideoBatch(ctx, db, ids)
+		if err != nil {
+			return result, err
+		}
+		result.Scanned += int64(len(ids))
+		result.Changed += changed
+		lastId = ids[len(ids)-1]
+	}
+}
+
+// reconcileVideoBatch 校准一批视频的计数，返回被修复的视频数量
+func reconcileVideoBatch(ctx context.Context, db *gorm.DB, ids []uint32) (int64, error) {
+	var changed int64
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁定本批视频，避免与Kafka增量更新交错
+		var current []*videoCounter
+		err := tx.Model(&Video{}).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id, favorite_count, comment_count").
+			Where("id IN ?", ids).Find(&current).Error
+		if err != nil {
+			return errors.Join(ErrMysqlQuery, err)
+		}
+		expected := make(map[string]map[uint32]uint32, len(counterQueries))
+		for column, query := range counterQueries {
+			var rows []*idCount
+			if err = tx.Raw(query, ids).Scan(&rows).Error; err != nil {
+				return errors.Join(ErrMysqlQuery, err)
+			}
+			counts := make(map[uint32]uint32, len(rows))
+			for _, row := range rows {
+				counts[row.Id] = row.Count
+			}
+			expected[column] = counts
+		}
+		for _, v := range current {
+			updates := diffVideoCounter(v, expected)
+			if len(updates) == 0 {
+				continue
+			}
+			err = tx.Model(&Video{}).Where("id = ?", v.Id).Updates(updates).Error
+			if err != nil {
+				return errors.Join(ErrMysqlUpdate, err)
+			}
+			changed++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return changed, nil
+}
+
+// diffVideoCounter 比较视频当前计数与源数据统计结果，返回需要修复的字段
+func diffVideoCounter(v *videoCounter, expected map[string]map[uint32]uint32) map[string]interface{} {
+	current := map[string]uint32{
+		"favorite_count": v.FavoriteCount,
+		"comment_count":  v.CommentCount,
+	}
+	updates := make(map[string]interface{})
+	for column, value := range current {
+		if want := expected[column][v.Id]; want != value {
+			updates[column] = want
+		}
+	}
+	return updates
+}
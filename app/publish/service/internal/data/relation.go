@@ -0,0 +1,27 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/toomanysource/atreus/api/relation/service/v1"
+)
+
+type relationRepo struct {
+	client pb.RelationServiceClient
+}
+
+func NewRelationRepo(conn pb.RelationServiceClient) RelationRepo {
+	return &relationRepo{
+		client: conn,
+	}
+}
+
+// GetMuteList 接收relation服务的回应，获取用户静音的用户id列表
+func (u *relationRepo) GetMuteList(ctx context.Context, userId uint32) ([]uint32, error) {
+	resp, err := u.client.GetMuteList(ctx, &pb.MuteListRequest{UserId: userId})
+	if err != nil {
+		return nil, errors.Join(ErrRelationServiceResponse, err)
+	}
+	return resp.UserIds, nil
+}
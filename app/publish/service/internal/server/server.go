@@ -16,11 +16,13 @@ import (
 	"github.com/hashicorp/consul/api"
 
 	favoritev1 "github.com/toomanysource/atreus/api/favorite/service/v1"
+	relationv1 "github.com/toomanysource/atreus/api/relation/service/v1"
 	userv1 "github.com/toomanysource/atreus/api/user/service/v1"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewUserClient, NewFavoriteClient, NewDiscovery, NewRegistrar)
+var ProviderSet = wire.NewSet(
+	NewGRPCServer, NewHTTPServer, NewUserClient, NewFavoriteClient, NewRelationClient, NewDiscovery, NewRegistrar)
 
 // NewUserClient 创建一个User服务客户端，接收User服务数据
 func NewUserClient(r registry.Discovery, logger log.Logger) userv1.UserServiceClient {
@@ -60,6 +62,25 @@ func NewFavoriteClient(r registry.Discovery, logger log.Logger) favoritev1.Favor
 	return favoritev1.NewFavoriteServiceClient(conn)
 }
 
+// NewRelationClient 创建一个Relation服务客户端，接收Relation服务数据
+func NewRelationClient(r registry.Discovery, logger log.Logger) relationv1.RelationServiceClient {
+	logs := log.NewHelper(log.With(logger, "module", "server/relation"))
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint("discovery:///atreus.relation.service"),
+		grpc.WithDiscovery(r),
+		grpc.WithMiddleware(
+			recovery.Recovery(),
+			logging.Client(logger),
+		),
+	)
+	if err != nil {
+		logs.Fatalf("relation service connect error, %v", err)
+	}
+	logs.Info("relation service connect successfully")
+	return relationv1.NewRelationServiceClient(conn)
+}
+
 func NewDiscovery(conf *conf.Registry) registry.Discovery {
 	c := api.DefaultConfig()
 	c.Address = conf.Consul.Address
@@ -0,0 +1,48 @@
+// dedup 清理followers表中的重复关注关系并重新计算相关计数。
+//
+// 旧版本的followers表只有普通索引，并发的重复请求可能插入重复的关系并重复计数，
+// 升级到带唯一索引的版本前需要先执行该命令，否则服务启动时无法创建唯一索引。
+// User服务中缓存的计数会在缓存过期后自动更新。
+//
+//	go run ./app/relation/service/cmd/dedup -conf app/relation/service/configs
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/toomanysource/atreus/app/relation/service/internal/conf"
+	"github.com/toomanysource/atreus/app/relation/service/internal/data"
+	"github.com/toomanysource/atreus/pkg/cmdX"
+)
+
+var flagConf string
+
+func init() {
+	flag.StringVar(&flagConf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+}
+
+func main() {
+	flag.Parse()
+	_, logs := cmdX.NewLogger("cmd/dedup")
+	var bc conf.Bootstrap
+	if err := cmdX.LoadConfig(flagConf, &bc); err != nil {
+		panic(err)
+	}
+	db, err := cmdX.OpenDB(bc.Data.Mysql.Dsn)
+	if err != nil {
+		logs.Fatalf("database connection failure, err : %v", err)
+	}
+	ctx := context.Background()
+	removed, err := data.DeduplicateFollowers(ctx, db)
+	if err != nil {
+		logs.Fatalf("deduplicate followers failed, err : %v", err)
+	}
+	logs.Infof("removed %d duplicate followers", removed)
+	// 去重后才能创建唯一索引
+	data.InitDB(db)
+	if err = data.RecomputeFollowCounters(ctx, db); err != nil {
+		logs.Fatalf("recompute follow counters failed, err : %v", err)
+	}
+	logs.Info("follow counters recomputed successfully")
+}
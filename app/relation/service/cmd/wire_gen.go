@@ -30,7 +30,8 @@ func wireApp(confServer *conf.Server, registry *conf.Registry, jwt *conf.JWT, co
 	}
 	discovery := server.NewDiscovery(registry)
 	userServiceClient := server.NewUserClient(discovery, logger)
-	relationRepo := data.NewRelationRepo(dataData, userServiceClient, logger)
+	messageServiceClient := server.NewMessageClient(discovery, logger)
+	relationRepo := data.NewRelationRepo(dataData, userServiceClient, messageServiceClient, logger)
 	relationUseCase := biz.NewRelationUseCase(relationRepo, logger)
 	relationService := service.NewRelationService(relationUseCase, logger)
 	grpcServer := server.NewGRPCServer(confServer, relationService, logger)
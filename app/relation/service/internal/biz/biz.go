@@ -13,4 +13,28 @@ const (
 	UnfollowType uint32 = 2
 )
 
+const (
+	BlockType   uint32 = 1
+	UnblockType uint32 = 2
+)
+
+const (
+	MuteType   uint32 = 1
+	UnmuteType uint32 = 2
+)
+
+const (
+	AcceptType uint32 = 1
+	RejectType uint32 = 2
+)
+
+// 屏蔽关系的种类
+const (
+	KindBlock uint32 = 1 // 屏蔽，对方无法关注、私信和评论自己的视频
+	KindMute  uint32 = 2 // 静音，对方的视频不再出现在自己的视频流中
+)
+
+// FollowerPageSize 未指定数量时每页返回的粉丝数量
+const FollowerPageSize uint32 = 50
+
 var ErrInValidActionType = errors.New("invalid action type")
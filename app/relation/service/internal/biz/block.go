@@ -0,0 +1,87 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/toomanysource/atreus/middleware"
+)
+
+// BlockAction 屏蔽和取消屏蔽，重复操作视为成功
+func (uc *RelationUseCase) BlockAction(ctx context.Context, toUserId uint32, actionType uint32) error {
+	switch actionType {
+	// 1为屏蔽
+	case BlockType:
+		return uc.addBlock(ctx, toUserId, KindBlock)
+	// 2为取消屏蔽
+	case UnblockType:
+		return uc.delBlock(ctx, toUserId, KindBlock)
+	default:
+		return ErrInValidActionType
+	}
+}
+
+// MuteAction 静音和取消静音，重复操作视为成功
+func (uc *RelationUseCase) MuteAction(ctx context.Context, toUserId uint32, actionType uint32) error {
+	switch actionType {
+	// 1为静音
+	case MuteType:
+		return uc.addBlock(ctx, toUserId, KindMute)
+	// 2为取消静音
+	case UnmuteType:
+		return uc.delBlock(ctx, toUserId, KindMute)
+	default:
+		return ErrInValidActionType
+	}
+}
+
+// GetBlockList 获取当前用户的屏蔽列表
+func (uc *RelationUseCase) GetBlockList(ctx context.Context) ([]*User, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	users, err := uc.repo.GetBlockList(ctx, userId)
+	if err != nil {
+		uc.log.Errorf("GetBlockList error: %v", err)
+	}
+	return users, err
+}
+
+// GetMuteList 获取用户静音的用户id列表
+func (uc *RelationUseCase) GetMuteList(ctx context.Context, userId uint32) ([]uint32, error) {
+	ids, err := uc.repo.GetMuteIds(ctx, userId)
+	if err != nil {
+		uc.log.Errorf("GetMuteList error: %v", err)
+	}
+	return ids, err
+}
+
+// IsBlocked 判断toUserId中的用户是否屏蔽了userId
+func (uc *RelationUseCase) IsBlocked(ctx context.Context, userId uint32, toUserId []uint32) ([]bool, error) {
+	oks, err := uc.repo.IsBlocked(ctx, userId, toUserId)
+	if err != nil {
+		uc.log.Errorf("IsBlocked error: %v", err)
+	}
+	return oks, err
+}
+
+func (uc *RelationUseCase) addBlock(ctx context.Context, toUserId uint32, kind uint32) error {
+	changed, err := uc.repo.AddBlock(ctx, toUserId, kind)
+	if err != nil {
+		uc.log.Errorf("AddBlock error: %v", err)
+		return err
+	}
+	if !changed {
+		uc.log.Infof("block already exists, toUserId: %v, kind: %v", toUserId, kind)
+	}
+	return nil
+}
+
+func (uc *RelationUseCase) delBlock(ctx context.Context, toUserId uint32, kind uint32) error {
+	changed, err := uc.repo.DelBlock(ctx, toUserId, kind)
+	if err != nil {
+		uc.log.Errorf("DelBlock error: %v", err)
+		return err
+	}
+	if !changed {
+		uc.log.Infof("block not exists, toUserId: %v, kind: %v", toUserId, kind)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/toomanysource/atreus/middleware"
+)
+
+type Blocks struct {
+	UserId   uint32
+	TargetId uint32
+	Kind     uint32
+}
+
+var testBlocks = []*Blocks{
+	{
+		UserId:   3,
+		TargetId: 1,
+		Kind:     KindBlock,
+	},
+	{
+		UserId:   1,
+		TargetId: 5,
+		Kind:     KindMute,
+	},
+}
+
+func (m *MockRelationRepo) AddBlock(ctx context.Context, toUserId uint32, kind uint32) (bool, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	for _, v := range testBlocks {
+		if v.UserId == userId && v.TargetId == toUserId && v.Kind == kind {
+			return false, nil
+		}
+	}
+	testBlocks = append(testBlocks, &Blocks{UserId: userId, TargetId: toUserId, Kind: kind})
+	return true, nil
+}
+
+func (m *MockRelationRepo) DelBlock(ctx context.Context, toUserId uint32, kind uint32) (bool, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	for i, v := range testBlocks {
+		if v.UserId == userId && v.TargetId == toUserId && v.Kind == kind {
+			testBlocks = append(testBlocks[:i], testBlocks[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MockRelationRepo) GetBlockList(ctx context.Context, userId uint32) (u []*User, err error) {
+	for _, v := range testBlocks {
+		if v.UserId == userId && v.Kind == KindBlock {
+			u = append(u, &User{Id: v.TargetId})
+		}
+	}
+	return
+}
+
+func (m *MockRelationRepo) GetMuteIds(ctx context.Context, userId uint32) (ids []uint32, err error) {
+	for _, v := range testBlocks {
+		if v.UserId == userId && v.Kind == KindMute {
+			ids = append(ids, v.TargetId)
+		}
+	}
+	return
+}
+
+func (m *MockRelationRepo) IsBlocked(ctx context.Context, userId uint32, toUserId []uint32) ([]bool, error) {
+	oks := make([]bool, len(toUserId))
+	for i, id := range toUserId {
+		for _, v := range testBlocks {
+			if v.UserId == id && v.TargetId == userId && v.Kind == KindBlock {
+				oks[i] = true
+			}
+		}
+	}
+	return oks, nil
+}
+
+func TestRelationService_BlockAction(t *testing.T) {
+	err := useCase.BlockAction(ctx, 6, BlockType)
+	assert.Nil(t, err)
+	err = useCase.BlockAction(ctx, 6, BlockType)
+	assert.Nil(t, err)
+	users, err := useCase.GetBlockList(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(users))
+	assert.Equal(t, uint32(6), users[0].Id)
+	err = useCase.BlockAction(ctx, 6, UnblockType)
+	assert.Nil(t, err)
+	users, err = useCase.GetBlockList(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(users))
+	err = useCase.BlockAction(ctx, 6, 3)
+	assert.Equal(t, ErrInValidActionType, err)
+}
+
+func TestRelationService_MuteAction(t *testing.T) {
+	err := useCase.MuteAction(ctx, 7, MuteType)
+	assert.Nil(t, err)
+	ids, err := useCase.GetMuteList(ctx, 1)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []uint32{5, 7}, ids)
+	err = useCase.MuteAction(ctx, 7, UnmuteType)
+	assert.Nil(t, err)
+	ids, err = useCase.GetMuteList(ctx, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint32{5}, ids)
+	err = useCase.MuteAction(ctx, 7, 0)
+	assert.Equal(t, ErrInValidActionType, err)
+}
+
+func TestRelationService_IsBlocked(t *testing.T) {
+	oks, err := useCase.IsBlocked(ctx, 1, []uint32{3, 5})
+	assert.Nil(t, err)
+	assert.Equal(t, []bool{true, false}, oks)
+}
@@ -0,0 +1,23 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/toomanysource/atreus/middleware"
+)
+
+// DefaultCommonCount 未指定数量时返回的共同关注用户数量
+const DefaultCommonCount uint32 = 3
+
+// GetCommonFollowList 获取当前用户关注的人中同样关注了toUserId的用户，返回总人数和其中的count个用户
+func (uc *RelationUseCase) GetCommonFollowList(ctx context.Context, toUserId uint32, count uint32) (uint32, []*User, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	if count == 0 {
+		count = DefaultCommonCount
+	}
+	total, users, err := uc.repo.GetCommonFollows(ctx, userId, toUserId, int(count))
+	if err != nil {
+		uc.log.Errorf("GetCommonFollows error: %v", err)
+	}
+	return total, users, err
+}
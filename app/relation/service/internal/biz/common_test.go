@@ -0,0 +1,41 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (m *MockRelationRepo) GetCommonFollows(
+	ctx context.Context, userId, toUserId uint32, count int,
+) (total uint32, u []*User, err error) {
+	for _, f := range testUser {
+		if f.FollowerId != userId {
+			continue
+		}
+		for _, v := range testUser {
+			if v.Id == toUserId && v.FollowerId == f.Id {
+				total++
+				if len(u) < count {
+					u = append(u, &User{Id: f.Id})
+				}
+			}
+		}
+	}
+	return
+}
+
+func TestRelationService_GetCommonFollowList(t *testing.T) {
+	// 用户1只关注了用户2，而用户2是用户1的粉丝
+	total, users, err := useCase.GetCommonFollowList(ctx, 1, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), total)
+	assert.Equal(t, 1, len(users))
+	assert.Equal(t, uint32(2), users[0].Id)
+
+	total, users, err = useCase.GetCommonFollowList(ctx, 3, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), total)
+	assert.Equal(t, 0, len(users))
+}
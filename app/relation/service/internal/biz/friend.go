@@ -0,0 +1,70 @@
+package biz
+
+import (
+	"context"
+	"sort"
+
+	"github.com/toomanysource/atreus/middleware"
+)
+
+// FriendUser 好友信息及与该好友的最新聊天消息
+type FriendUser struct {
+	User
+	Message     string // 和该好友的最新聊天消息
+	MsgType     uint32 // 0-当前用户接收的消息，1-当前用户发送的消息
+	UnreadCount uint32 // 该好友发送的未读消息数量
+	MsgTime     int64  // 最新消息的时间，没有聊天记录时为0
+}
+
+// FriendMessage 用户与好友的最新聊天消息
+type FriendMessage struct {
+	ToUserId    uint32
+	Content     string
+	MsgType     uint32
+	UnreadCount uint32
+	CreateTime  int64
+}
+
+// GetFriendList 获取互相关注的好友列表，查询自己的好友列表时附带最新聊天消息和未读消息数量，按最新消息时间倒序排列
+func (uc *RelationUseCase) GetFriendList(ctx context.Context, userId uint32) ([]*FriendUser, error) {
+	currentId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	if userId == 0 {
+		userId = currentId
+	}
+	users, err := uc.repo.GetFriendList(ctx, userId)
+	if err != nil {
+		uc.log.Errorf("GetFriendList error: %v", err)
+		return nil, err
+	}
+	friends := make([]*FriendUser, 0, len(users))
+	for _, u := range users {
+		friends = append(friends, &FriendUser{User: *u})
+	}
+	// 聊天消息只对本人可见
+	if userId != currentId || len(friends) == 0 {
+		return friends, nil
+	}
+	ids := make([]uint32, 0, len(friends))
+	for _, f := range friends {
+		ids = append(ids, f.Id)
+	}
+	// 消息服务不可用时仍返回好友列表
+	messages, err := uc.repo.GetLatestMessages(ctx, userId, ids)
+	if err != nil {
+		uc.log.Errorf("GetLatestMessages error: %v", err)
+		return friends, nil
+	}
+	latest := make(map[uint32]*FriendMessage, len(messages))
+	for _, m := range messages {
+		latest[m.ToUserId] = m
+	}
+	for _, f := range friends {
+		if m, ok := latest[f.Id]; ok {
+			f.Message, f.MsgType, f.UnreadCount, f.MsgTime = m.Content, m.MsgType, m.UnreadCount, m.CreateTime
+		}
+	}
+	sort.SliceStable(friends, func(i, j int) bool {
+		return friends[i].MsgTime > friends[j].MsgTime
+	})
+	return friends, nil
+}
@@ -0,0 +1,60 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (m *MockRelationRepo) GetFriendList(ctx context.Context, userId uint32) (u []*User, err error) {
+	for _, f := range testUser {
+		if f.FollowerId != userId {
+			continue
+		}
+		for _, v := range testUser {
+			if v.Id == userId && v.FollowerId == f.Id {
+				u = append(u, &User{Id: f.Id, IsFollow: true})
+			}
+		}
+	}
+	return
+}
+
+func (m *MockRelationRepo) GetLatestMessages(
+	ctx context.Context, userId uint32, toUserIds []uint32,
+) ([]*FriendMessage, error) {
+	messages := make([]*FriendMessage, 0, len(toUserIds))
+	for _, id := range toUserIds {
+		// 只有用户2与用户1有聊天记录
+		if id == 2 {
+			messages = append(messages, &FriendMessage{
+				ToUserId: id, Content: "hello", MsgType: 0, UnreadCount: 2, CreateTime: 100,
+			})
+			continue
+		}
+		messages = append(messages, &FriendMessage{ToUserId: id})
+	}
+	return messages, nil
+}
+
+func TestRelationService_GetFriendList(t *testing.T) {
+	// 用户3和用户5只是用户1的粉丝，不是好友
+	friends, err := useCase.GetFriendList(ctx, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(friends))
+	assert.Equal(t, uint32(2), friends[0].Id)
+	assert.Equal(t, "hello", friends[0].Message)
+	assert.Equal(t, uint32(2), friends[0].UnreadCount)
+
+	// 查看他人的好友列表时不附带聊天消息
+	friends, err = useCase.GetFriendList(ctx, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(friends))
+	assert.Equal(t, uint32(1), friends[0].Id)
+	assert.Equal(t, "", friends[0].Message)
+
+	friends, err = useCase.GetFriendList(ctx, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(friends))
+}
@@ -24,10 +24,25 @@ type User struct {
 
 type RelationRepo interface {
 	GetFollowList(context.Context, uint32) ([]*User, error)
-	GetFollowerList(context.Context, uint32) ([]*User, error)
-	Follow(context.Context, uint32) error
-	UnFollow(context.Context, uint32) error
+	GetFollowerList(ctx context.Context, userId, cursor uint32, count int) ([]*User, uint32, error)
+	GetFriendList(context.Context, uint32) ([]*User, error)
+	Follow(context.Context, uint32) (bool, error)
+	UnFollow(context.Context, uint32) (bool, error)
 	IsFollow(ctx context.Context, userId uint32, toUserId []uint32) ([]bool, error)
+	AddBlock(ctx context.Context, toUserId uint32, kind uint32) (bool, error)
+	DelBlock(ctx context.Context, toUserId uint32, kind uint32) (bool, error)
+	GetBlockList(context.Context, uint32) ([]*User, error)
+	GetMuteIds(context.Context, uint32) ([]uint32, error)
+	IsBlocked(ctx context.Context, userId uint32, toUserId []uint32) ([]bool, error)
+	IsPrivate(context.Context, uint32) (bool, error)
+	AddFollowRequest(context.Context, uint32) (bool, error)
+	DelFollowRequest(context.Context, uint32) (bool, error)
+	AcceptFollowRequest(context.Context, uint32) (bool, error)
+	RejectFollowRequest(context.Context, uint32) (bool, error)
+	GetFollowRequestList(context.Context, uint32) ([]*User, error)
+	SuggestUsers(ctx context.Context, userId uint32, count int) ([]*User, error)
+	GetCommonFollows(ctx context.Context, userId, toUserId uint32, count int) (uint32, []*User, error)
+	GetLatestMessages(ctx context.Context, userId uint32, toUserIds []uint32) ([]*FriendMessage, error)
 }
 
 type RelationUseCase struct {
@@ -56,40 +71,64 @@ func (uc *RelationUseCase) GetFollowList(ctx context.Context, userId uint32) ([]
 	return users, err
 }
 
-// GetFollowerList 获取粉丝列表
-func (uc *RelationUseCase) GetFollowerList(ctx context.Context, userId uint32) ([]*User, error) {
+// GetFollowerList 按关注时间从新到旧分页获取粉丝列表，返回下一页的游标，没有更多粉丝时为0
+func (uc *RelationUseCase) GetFollowerList(
+	ctx context.Context, userId, cursor, count uint32,
+) ([]*User, uint32, error) {
 	if userId == 0 {
-		userID := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
-		users, err := uc.repo.GetFollowerList(ctx, userID)
-		if err != nil {
-			uc.log.Errorf("GetFollowerList error: %v", err)
-		}
-		return users, err
+		userId = ctx.Value(middleware.UserIdKey("user_id")).(uint32)
 	}
-	users, err := uc.repo.GetFollowerList(ctx, userId)
+	if count == 0 {
+		count = FollowerPageSize
+	}
+	users, next, err := uc.repo.GetFollowerList(ctx, userId, cursor, int(count))
 	if err != nil {
 		uc.log.Errorf("GetFollowerList error: %v", err)
 	}
-	return users, err
+	return users, next, err
 }
 
-// Action 关注和取消关注
-func (uc *RelationUseCase) Action(ctx context.Context, toUserId uint32, actionType uint32) (err error) {
+// Action 关注和取消关注，重复操作视为成功。
+// 关注私密账号时只发送关注请求，返回值表示关注请求是否在等待对方同意
+func (uc *RelationUseCase) Action(ctx context.Context, toUserId uint32, actionType uint32) (bool, error) {
 	switch actionType {
 	// 1为关注
 	case FollowType:
-		if err = uc.repo.Follow(ctx, toUserId); err != nil {
+		private, err := uc.repo.IsPrivate(ctx, toUserId)
+		if err != nil {
+			uc.log.Errorf("IsPrivate error: %v", err)
+			return false, err
+		}
+		if private {
+			return uc.requestFollow(ctx, toUserId)
+		}
+		changed, err := uc.repo.Follow(ctx, toUserId)
+		if err != nil {
 			uc.log.Errorf("Follow error: %v", err)
+			return false, err
 		}
-		return err
-	// 2为取消关注
+		if !changed {
+			uc.log.Infof("relation already exists, toUserId: %v", toUserId)
+		}
+		return false, nil
+	// 2为取消关注，同时撤回尚未处理的关注请求
 	case UnfollowType:
-		if err = uc.repo.UnFollow(ctx, toUserId); err != nil {
+		changed, err := uc.repo.UnFollow(ctx, toUserId)
+		if err != nil {
 			uc.log.Errorf("UnFollow error: %v", err)
+			return false, err
+		}
+		withdrawn, err := uc.repo.DelFollowRequest(ctx, toUserId)
+		if err != nil {
+			uc.log.Errorf("DelFollowRequest error: %v", err)
+			return false, err
+		}
+		if !changed && !withdrawn {
+			uc.log.Infof("relation not exists, toUserId: %v", toUserId)
 		}
-		return err
+		return false, nil
 	default:
-		return ErrInValidActionType
+		return false, ErrInValidActionType
 	}
 }
 
@@ -53,27 +53,57 @@ func (m *MockRelationRepo) GetFollowList(ctx context.Context, userId uint32) (u
 	return
 }
 
-func (m *MockRelationRepo) GetFollowerList(ctx context.Context, userId uint32) (u []*User, err error) {
-	for _, v := range testUser {
-		if v.Id == userId {
-			u = append(u, &User{
-				Id: v.FollowerId,
-			})
+func (m *MockRelationRepo) GetFollowerList(
+	ctx context.Context, userId, cursor uint32, count int,
+) (u []*User, next uint32, err error) {
+	// 以粉丝在testUser中的下标加一作为游标，越靠后的关注越新
+	for i := len(testUser) - 1; i >= 0; i-- {
+		v := testUser[i]
+		if v.Id != userId || (cursor != 0 && uint32(i+1) >= cursor) {
+			continue
+		}
+		u = append(u, &User{
+			Id: v.FollowerId,
+		})
+		if len(u) == count {
+			return u, uint32(i + 1), nil
 		}
 	}
 	return
 }
 
-func (m *MockRelationRepo) Follow(ctx context.Context, userId uint32) error {
-	return nil
+func (m *MockRelationRepo) Follow(ctx context.Context, userId uint32) (bool, error) {
+	followerId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	for _, v := range testUser {
+		if v.Id == userId && v.FollowerId == followerId {
+			return false, nil
+		}
+	}
+	testUser = append(testUser, &Followers{Id: userId, FollowerId: followerId})
+	return true, nil
 }
 
-func (m *MockRelationRepo) UnFollow(ctx context.Context, userId uint32) error {
-	return nil
+func (m *MockRelationRepo) UnFollow(ctx context.Context, userId uint32) (bool, error) {
+	followerId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	for i, v := range testUser {
+		if v.Id == userId && v.FollowerId == followerId {
+			testUser = append(testUser[:i], testUser[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
 }
 
 func (m *MockRelationRepo) IsFollow(ctx context.Context, userId uint32, toUserId []uint32) ([]bool, error) {
-	return []bool{true}, nil
+	oks := make([]bool, len(toUserId))
+	for i, id := range toUserId {
+		for _, v := range testUser {
+			if v.Id == id && v.FollowerId == userId {
+				oks[i] = true
+			}
+		}
+	}
+	return oks, nil
 }
 
 func TestMain(m *testing.M) {
@@ -92,20 +122,46 @@ func TestRelationService_GetFollowList(t *testing.T) {
 }
 
 func TestRelationService_GetFollowerList(t *testing.T) {
-	users, err := useCase.GetFollowerList(ctx, 1)
+	users, next, err := useCase.GetFollowerList(ctx, 1, 0, 0)
 	assert.Nil(t, err)
 	assert.Equal(t, 3, len(users))
-	_, err = useCase.GetFollowerList(ctx, 0)
+	assert.Equal(t, uint32(0), next)
+	_, _, err = useCase.GetFollowerList(ctx, 0, 0, 0)
+	assert.Nil(t, err)
+
+	// 分页获取，每页2个粉丝
+	users, next, err = useCase.GetFollowerList(ctx, 1, 0, 2)
 	assert.Nil(t, err)
+	assert.Equal(t, []uint32{5, 3}, []uint32{users[0].Id, users[1].Id})
+	assert.NotEqual(t, uint32(0), next)
+	users, next, err = useCase.GetFollowerList(ctx, 1, next, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(users))
+	assert.Equal(t, uint32(2), users[0].Id)
+	assert.Equal(t, uint32(0), next)
 }
 
 func TestRelationService_Action(t *testing.T) {
-	err := useCase.Action(ctx, 8, FollowType)
+	_, err := useCase.Action(ctx, 8, FollowType)
 	assert.Nil(t, err)
-	err = useCase.Action(ctx, 8, UnfollowType)
+	_, err = useCase.Action(ctx, 8, UnfollowType)
 	assert.Nil(t, err)
 }
 
+func TestRelationService_ActionIdempotent(t *testing.T) {
+	before := len(testUser)
+	for i := 0; i < 2; i++ {
+		_, err := useCase.Action(ctx, 9, FollowType)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, before+1, len(testUser))
+	for i := 0; i < 2; i++ {
+		_, err := useCase.Action(ctx, 9, UnfollowType)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, before, len(testUser))
+}
+
 func TestRelationService_IsFollow(t *testing.T) {
 	b, err := useCase.IsFollow(ctx, 1, []uint32{2})
 	assert.Nil(t, err)
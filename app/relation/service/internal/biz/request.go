@@ -0,0 +1,70 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/toomanysource/atreus/middleware"
+)
+
+// requestFollow 向私密账号发送关注请求，已关注时视为成功，返回关注请求是否在等待对方同意
+func (uc *RelationUseCase) requestFollow(ctx context.Context, toUserId uint32) (bool, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	oks, err := uc.repo.IsFollow(ctx, userId, []uint32{toUserId})
+	if err != nil {
+		uc.log.Errorf("IsFollow error: %v", err)
+		return false, err
+	}
+	if oks[0] {
+		uc.log.Infof("relation already exists, toUserId: %v", toUserId)
+		return false, nil
+	}
+	changed, err := uc.repo.AddFollowRequest(ctx, toUserId)
+	if err != nil {
+		uc.log.Errorf("AddFollowRequest error: %v", err)
+		return false, err
+	}
+	if !changed {
+		uc.log.Infof("follow request already exists, toUserId: %v", toUserId)
+	}
+	return true, nil
+}
+
+// FollowRequestAction 同意或拒绝关注请求，重复操作视为成功
+func (uc *RelationUseCase) FollowRequestAction(ctx context.Context, fromUserId uint32, actionType uint32) error {
+	switch actionType {
+	// 1为同意，建立关注关系
+	case AcceptType:
+		changed, err := uc.repo.AcceptFollowRequest(ctx, fromUserId)
+		if err != nil {
+			uc.log.Errorf("AcceptFollowRequest error: %v", err)
+			return err
+		}
+		if !changed {
+			uc.log.Infof("follow request not exists, fromUserId: %v", fromUserId)
+		}
+		return nil
+	// 2为拒绝
+	case RejectType:
+		changed, err := uc.repo.RejectFollowRequest(ctx, fromUserId)
+		if err != nil {
+			uc.log.Errorf("RejectFollowRequest error: %v", err)
+			return err
+		}
+		if !changed {
+			uc.log.Infof("follow request not exists, fromUserId: %v", fromUserId)
+		}
+		return nil
+	default:
+		return ErrInValidActionType
+	}
+}
+
+// GetFollowRequestList 获取当前用户待处理的关注请求
+func (uc *RelationUseCase) GetFollowRequestList(ctx context.Context) ([]*User, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	users, err := uc.repo.GetFollowRequestList(ctx, userId)
+	if err != nil {
+		uc.log.Errorf("GetFollowRequestList error: %v", err)
+	}
+	return users, err
+}
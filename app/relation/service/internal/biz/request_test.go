@@ -0,0 +1,125 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/toomanysource/atreus/middleware"
+)
+
+type FollowRequests struct {
+	UserId      uint32
+	RequesterId uint32
+}
+
+var (
+	testPrivate  = map[uint32]bool{6: true, 7: true}
+	testRequests = []*FollowRequests{
+		{
+			UserId:      1,
+			RequesterId: 4,
+		},
+	}
+)
+
+func (m *MockRelationRepo) IsPrivate(ctx context.Context, userId uint32) (bool, error) {
+	return testPrivate[userId], nil
+}
+
+func (m *MockRelationRepo) AddFollowRequest(ctx context.Context, toUserId uint32) (bool, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	for _, v := range testRequests {
+		if v.UserId == toUserId && v.RequesterId == userId {
+			return false, nil
+		}
+	}
+	testRequests = append(testRequests, &FollowRequests{UserId: toUserId, RequesterId: userId})
+	return true, nil
+}
+
+func (m *MockRelationRepo) DelFollowRequest(ctx context.Context, toUserId uint32) (bool, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	return delTestRequest(toUserId, userId), nil
+}
+
+func (m *MockRelationRepo) AcceptFollowRequest(ctx context.Context, fromUserId uint32) (bool, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	if !delTestRequest(userId, fromUserId) {
+		return false, nil
+	}
+	testUser = append(testUser, &Followers{Id: userId, FollowerId: fromUserId})
+	return true, nil
+}
+
+func (m *MockRelationRepo) RejectFollowRequest(ctx context.Context, fromUserId uint32) (bool, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	return delTestRequest(userId, fromUserId), nil
+}
+
+func (m *MockRelationRepo) GetFollowRequestList(ctx context.Context, userId uint32) (u []*User, err error) {
+	for _, v := range testRequests {
+		if v.UserId == userId {
+			u = append(u, &User{Id: v.RequesterId})
+		}
+	}
+	return
+}
+
+func delTestRequest(userId, requesterId uint32) bool {
+	for i, v := range testRequests {
+		if v.UserId == userId && v.RequesterId == requesterId {
+			testRequests = append(testRequests[:i], testRequests[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func TestRelationService_FollowPrivate(t *testing.T) {
+	before := len(testUser)
+	for i := 0; i < 2; i++ {
+		pending, err := useCase.Action(ctx, 7, FollowType)
+		assert.Nil(t, err)
+		assert.True(t, pending)
+	}
+	assert.Equal(t, before, len(testUser))
+	// 取消关注同时撤回关注请求
+	_, err := useCase.Action(ctx, 7, UnfollowType)
+	assert.Nil(t, err)
+	assert.False(t, delTestRequest(7, 1))
+
+	// 已关注的账号改为私密后，再次关注不会产生关注请求
+	testPrivate[2] = true
+	defer delete(testPrivate, 2)
+	pending, err := useCase.Action(ctx, 2, FollowType)
+	assert.Nil(t, err)
+	assert.False(t, pending)
+	assert.False(t, delTestRequest(2, 1))
+}
+
+func TestRelationService_FollowRequestAction(t *testing.T) {
+	users, err := useCase.GetFollowRequestList(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(users))
+
+	before := len(testUser)
+	for i := 0; i < 2; i++ {
+		err = useCase.FollowRequestAction(ctx, 4, AcceptType)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, before+1, len(testUser))
+	users, err = useCase.GetFollowRequestList(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(users))
+
+	testRequests = append(testRequests, &FollowRequests{UserId: 1, RequesterId: 6})
+	err = useCase.FollowRequestAction(ctx, 6, RejectType)
+	assert.Nil(t, err)
+	assert.Equal(t, before+1, len(testUser))
+	assert.False(t, delTestRequest(1, 6))
+
+	err = useCase.FollowRequestAction(ctx, 4, 3)
+	assert.ErrorIs(t, err, ErrInValidActionType)
+}
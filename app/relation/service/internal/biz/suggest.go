@@ -0,0 +1,75 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/toomanysource/atreus/middleware"
+)
+
+// DefaultSuggestCount 未指定数量时返回的推荐用户数量
+const DefaultSuggestCount uint32 = 20
+
+// 推荐候选用户的排序权重，关注的人也关注了对方比共同粉丝更能说明可能认识
+const (
+	MutualFollowWeight   uint32 = 2
+	SharedFollowerWeight uint32 = 1
+)
+
+// SuggestCandidate 推荐候选用户
+type SuggestCandidate struct {
+	Id              uint32 // 候选用户id
+	MutualFollows   uint32 // 当前用户关注的人中关注了候选用户的人数
+	SharedFollowers uint32 // 同时关注了当前用户和候选用户的人数
+}
+
+// Score 候选用户的推荐分数
+func (c *SuggestCandidate) Score() uint32 {
+	return c.MutualFollows*MutualFollowWeight + c.SharedFollowers*SharedFollowerWeight
+}
+
+// RankScore 推荐分数为高位、id取反为低位的组合分数，按组合分数从高到低排序与RankSuggestions的顺序一致，
+// 用于有序集合缓存。推荐分数小于2^21时float64可以精确表示
+func (c *SuggestCandidate) RankScore() float64 {
+	return float64(c.Score())*(1<<32) + float64(math.MaxUint32-c.Id)
+}
+
+// RankSuggestions 合并同一用户的候选记录，按推荐分数从高到低排序，分数相同时id小的在前，最多返回limit个
+func RankSuggestions(candidates []*SuggestCandidate, limit int) []*SuggestCandidate {
+	merged := make(map[uint32]*SuggestCandidate, len(candidates))
+	ranked := make([]*SuggestCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		if m, ok := merged[c.Id]; ok {
+			m.MutualFollows += c.MutualFollows
+			m.SharedFollowers += c.SharedFollowers
+			continue
+		}
+		m := *c
+		merged[c.Id] = &m
+		ranked = append(ranked, &m)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if si, sj := ranked[i].Score(), ranked[j].Score(); si != sj {
+			return si > sj
+		}
+		return ranked[i].Id < ranked[j].Id
+	})
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked
+}
+
+// SuggestUsers 获取当前用户可能认识的人
+func (uc *RelationUseCase) SuggestUsers(ctx context.Context, count uint32) ([]*User, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	if count == 0 {
+		count = DefaultSuggestCount
+	}
+	users, err := uc.repo.SuggestUsers(ctx, userId, int(count))
+	if err != nil {
+		uc.log.Errorf("SuggestUsers error: %v", err)
+	}
+	return users, err
+}
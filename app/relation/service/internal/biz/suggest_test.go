@@ -0,0 +1,50 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (m *MockRelationRepo) SuggestUsers(ctx context.Context, userId uint32, count int) (u []*User, err error) {
+	for i := 0; i < count; i++ {
+		u = append(u, &User{Id: uint32(i + 100)})
+	}
+	return
+}
+
+func TestRankSuggestions(t *testing.T) {
+	candidates := []*SuggestCandidate{
+		{Id: 4, SharedFollowers: 3},
+		{Id: 2, MutualFollows: 1},
+		{Id: 3, MutualFollows: 2},
+		{Id: 2, SharedFollowers: 2},
+		{Id: 5, SharedFollowers: 1},
+	}
+	ranked := RankSuggestions(candidates, 3)
+	assert.Equal(t, 3, len(ranked))
+	// 2: 1*2+2=4，3: 2*2=4，4: 3，5: 1
+	assert.Equal(t, uint32(2), ranked[0].Id)
+	assert.Equal(t, uint32(4), ranked[0].Score())
+	assert.Equal(t, uint32(3), ranked[1].Id)
+	assert.Equal(t, uint32(4), ranked[2].Id)
+	// 合并时不修改传入的候选记录
+	assert.Equal(t, uint32(0), candidates[1].SharedFollowers)
+	// 组合分数的顺序与排序结果一致，推荐分数相同时id小的在前
+	for i := 1; i < len(ranked); i++ {
+		assert.Greater(t, ranked[i-1].RankScore(), ranked[i].RankScore())
+	}
+	tied := []*SuggestCandidate{{Id: 10, SharedFollowers: 1}, {Id: 9, SharedFollowers: 1}}
+	assert.Greater(t, tied[1].RankScore(), tied[0].RankScore())
+	assert.Equal(t, uint32(9), RankSuggestions(tied, 2)[0].Id)
+}
+
+func TestRelationService_SuggestUsers(t *testing.T) {
+	users, err := useCase.SuggestUsers(ctx, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int(DefaultSuggestCount), len(users))
+	users, err = useCase.SuggestUsers(ctx, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(users))
+}
@@ -0,0 +1,237 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/toomanysource/atreus/app/relation/service/internal/biz"
+	"github.com/toomanysource/atreus/middleware"
+)
+
+// 屏蔽关系缓存的key前缀
+const (
+	blockKeyPrefix     = "block:"      // 用户屏蔽的用户
+	blockedByKeyPrefix = "blocked_by:" // 屏蔽了用户的用户
+	muteKeyPrefix      = "mute:"       // 用户静音的用户
+)
+
+// blockCacheDelay 屏蔽关系变更后再次删除缓存的延迟，清除变更提交前读取数据库的请求异步回填的旧缓存
+const blockCacheDelay = time.Second
+
+// Block 屏蔽和静音关系，UserId对TargetId执行了Kind类型的操作
+type Block struct {
+	Id       uint32 `gorm:"primary_key"`
+	UserId   uint32 `gorm:"column:user_id;not null;uniqueIndex:uk_user_target_kind"`
+	TargetId uint32 `gorm:"column:target_id;not null;uniqueIndex:uk_user_target_kind;index:idx_target_id"`
+	Kind     uint32 `gorm:"column:kind;not null;uniqueIndex:uk_user_target_kind"`
+}
+
+func (Block) TableName() string {
+	return "blocks"
+}
+
+// AddBlock 屏蔽或静音用户，屏蔽时同时解除双方的关注关系和关注请求，返回状态是否发生变化
+func (r *relationRepo) AddBlock(ctx context.Context, toUserId uint32, kind uint32) (bool, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	if userId == toUserId {
+		return false, ErrBlockYourself
+	}
+	var changed bool
+	var removed [2]bool
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) (err error) {
+		result := tx.Model(&Block{}).
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&Block{UserId: userId, TargetId: toUserId, Kind: kind})
+		if result.Error != nil {
+			return errors.Join(ErrMysqlInsert, result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		changed = true
+		if kind == biz.KindBlock {
+			if removed[0], err = r.delFollowTx(tx, userId, toUserId); err != nil {
+				return err
+			}
+			if removed[1], err = r.delFollowTx(tx, toUserId, userId); err != nil {
+				return err
+			}
+			// 双方之间尚未处理的关注请求一并删除
+			if _, err = delFollowRequestTx(tx, userId, toUserId); err != nil {
+				return err
+			}
+			if _, err = delFollowRequestTx(tx, toUserId, userId); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil || !changed {
+		return false, err
+	}
+	r.invalidateBlockCache(ctx, userId, toUserId, kind)
+	if removed[0] {
+		r.removeFollowCaches(ctx, userId, toUserId)
+	}
+	if removed[1] {
+		r.removeFollowCaches(ctx, toUserId, userId)
+	}
+	r.log.Infof("AddBlock -> userId: %v - toUserId: %v - kind: %v", userId, toUserId, kind)
+	return true, nil
+}
+
+// DelBlock 取消屏蔽或静音，返回状态是否发生变化
+func (r *relationRepo) DelBlock(ctx context.Context, toUserId uint32, kind uint32) (bool, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	result := r.data.db.WithContext(ctx).Model(&Block{}).
+		Where("user_id = ? AND target_id = ? AND kind = ?", userId, toUserId, kind).
+		Delete(&Block{})
+	if result.Error != nil {
+		return false, errors.Join(ErrMysqlDelete, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+	r.invalidateBlockCache(ctx, userId, toUserId, kind)
+	r.log.Infof("DelBlock -> userId: %v - toUserId: %v - kind: %v", userId, toUserId, kind)
+	return true, nil
+}
+
+// GetBlockList 获取用户的屏蔽列表
+func (r *relationRepo) GetBlockList(ctx context.Context, userId uint32) ([]*biz.User, error) {
+	ids, err := r.getBlockIds(ctx, blockKeyPrefix, userId)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return r.userRepo.GetUserInfos(ctx, userId, ids)
+}
+
+// GetMuteIds 获取用户静音的用户id列表
+func (r *relationRepo) GetMuteIds(ctx context.Context, userId uint32) ([]uint32, error) {
+	return r.getBlockIds(ctx, muteKeyPrefix, userId)
+}
+
+// IsBlocked 判断toUserId中的用户是否屏蔽了userId
+func (r *relationRepo) IsBlocked(ctx context.Context, userId uint32, toUserId []uint32) ([]bool, error) {
+	ids, err := r.getBlockIds(ctx, blockedByKeyPrefix, userId)
+	if err != nil {
+		return nil, err
+	}
+	blockedBy := make(map[uint32]bool, len(ids))
+	for _, id := range ids {
+		blockedBy[id] = true
+	}
+	oks := make([]bool, 0, len(toUserId))
+	for _, id := range toUserId {
+		oks = append(oks, blockedBy[id])
+	}
+	return oks, nil
+}
+
+// isBlockedEither 判断userId和toUserId之间是否存在任意方向的屏蔽关系
+func (r *relationRepo) isBlockedEither(ctx context.Context, userId, toUserId uint32) (bool, error) {
+	oks, err := r.IsBlocked(ctx, userId, []uint32{toUserId})
+	if err != nil {
+		return false, err
+	}
+	if oks[0] {
+		return true, nil
+	}
+	oks, err = r.IsBlocked(ctx, toUserId, []uint32{userId})
+	if err != nil {
+		return false, err
+	}
+	return oks[0], nil
+}
+
+// getBlockIds 获取屏蔽关系中的用户id列表，缓存不存在时从数据库查询并创建缓存
+func (r *relationRepo) getBlockIds(ctx context.Context, prefix string, userId uint32) ([]uint32, error) {
+	key := prefix + strconv.Itoa(int(userId))
+	values, err := r.data.cache.blockRelation.HKeys(ctx, key).Result()
+	if err != nil {
+		return nil, errors.Join(ErrRedisQuery, err)
+	}
+	if len(values) > 0 {
+		ids := make([]uint32, 0, len(values))
+		for _, v := range values {
+			if v == OccupyKey {
+				continue
+			}
+			id, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, uint32(id))
+		}
+		return ids, nil
+	}
+	ids, err := r.GetBlockIdsFromDB(ctx, prefix, userId)
+	if err != nil {
+		return nil, err
+	}
+	// 将屏蔽关系存入redis缓存，空列表也会缓存占位键
+	go func() {
+		if err := createCacheByKey(context.Background(), r.data.cache.blockRelation, ids, key); err != nil {
+			r.log.Error(err)
+			return
+		}
+		r.log.Info("redis transaction success")
+	}()
+	return ids, nil
+}
+
+// GetBlockIdsFromDB 数据库查询屏蔽关系中的用户id列表
+func (r *relationRepo) GetBlockIdsFromDB(ctx context.Context, prefix string, userId uint32) ([]uint32, error) {
+	var ids []uint32
+	db := r.data.db.WithContext(ctx).Model(&Block{})
+	column := "target_id"
+	switch prefix {
+	case blockKeyPrefix:
+		db = db.Where("user_id = ? AND kind = ?", userId, biz.KindBlock)
+	case blockedByKeyPrefix:
+		db = db.Where("target_id = ? AND kind = ?", userId, biz.KindBlock)
+		column = "user_id"
+	case muteKeyPrefix:
+		db = db.Where("user_id = ? AND kind = ?", userId, biz.KindMute)
+	}
+	if err := db.Pluck(column, &ids).Error; err != nil {
+		return nil, errors.Join(ErrMysqlQuery, err)
+	}
+	return ids, nil
+}
+
+// invalidateBlockCache 屏蔽关系变更提交后删除缓存，并在blockCacheDelay后再次删除，
+// 第一次删除失败时由第二次删除补偿
+func (r *relationRepo) invalidateBlockCache(ctx context.Context, userId, toUserId uint32, kind uint32) {
+	if err := r.DeleteBlockCache(ctx, userId, toUserId, kind); err != nil {
+		r.log.Error(err)
+	}
+	time.AfterFunc(blockCacheDelay, func() {
+		if err := r.DeleteBlockCache(context.Background(), userId, toUserId, kind); err != nil {
+			r.log.Error(err)
+		}
+	})
+}
+
+// DeleteBlockCache 删除屏蔽关系变化涉及的缓存，下次读取时重新创建
+func (r *relationRepo) DeleteBlockCache(ctx context.Context, userId, toUserId uint32, kind uint32) error {
+	keys := []string{muteKeyPrefix + strconv.Itoa(int(userId))}
+	if kind == biz.KindBlock {
+		keys = []string{
+			blockKeyPrefix + strconv.Itoa(int(userId)),
+			blockedByKeyPrefix + strconv.Itoa(int(toUserId)),
+		}
+	}
+	if err := r.data.cache.blockRelation.Del(ctx, keys...).Err(); err != nil {
+		return errors.Join(ErrRedisDelete, err)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/toomanysource/atreus/app/relation/service/internal/biz"
+)
+
+// GetCommonFollows 获取userId关注的人中同样关注了toUserId的用户，返回总人数和id最小的count个用户。
+// 对方的粉丝缓存包含全部粉丝时从缓存中判断，否则查询数据库
+func (r *relationRepo) GetCommonFollows(
+	ctx context.Context, userId, toUserId uint32, count int,
+) (uint32, []*biz.User, error) {
+	follows, err := r.getFollowIds(ctx, userId)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(follows) == 0 {
+		return 0, nil, nil
+	}
+	common, err := r.getCommonFromCache(ctx, follows, toUserId)
+	if err != nil {
+		return 0, nil, err
+	}
+	if common == nil {
+		common, err = r.GetCommonFromDB(ctx, follows, toUserId)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	if len(common) == 0 {
+		return 0, nil, nil
+	}
+	sort.Slice(common, func(i, j int) bool { return common[i] < common[j] })
+	top := common
+	if len(top) > count {
+		top = top[:count]
+	}
+	users, err := r.userRepo.GetUserInfos(ctx, userId, top)
+	if err != nil {
+		return 0, nil, err
+	}
+	// 都是自己关注的用户
+	for _, user := range users {
+		user.IsFollow = true
+	}
+	return uint32(len(common)), users, nil
+}
+
+// getCommonFromCache 通过toUserId的粉丝缓存判断follows中的用户是否关注了对方，
+// 缓存不存在或只包含部分粉丝时返回nil
+func (r *relationRepo) getCommonFromCache(ctx context.Context, follows []uint32, toUserId uint32) ([]uint32, error) {
+	oks, err := r.getFollowerScores(ctx, toUserId, follows)
+	if err != nil || oks == nil {
+		return nil, err
+	}
+	common := make([]uint32, 0)
+	for i, ok := range oks {
+		if ok {
+			common = append(common, follows[i])
+		}
+	}
+	return common, nil
+}
+
+// GetCommonFromDB 数据库查询follows中关注了toUserId的用户
+func (r *relationRepo) GetCommonFromDB(ctx context.Context, follows []uint32, toUserId uint32) ([]uint32, error) {
+	common := make([]uint32, 0)
+	err := r.data.db.WithContext(ctx).Model(&Followers{}).
+		Where("user_id = ? AND follower_id IN ?", toUserId, follows).
+		Pluck("follower_id", &common).Error
+	if err != nil {
+		return nil, errors.Join(ErrMysqlQuery, err)
+	}
+	return common, nil
+}
@@ -0,0 +1,34 @@
+package data
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/toomanysource/atreus/pkg/sqlmockX"
+)
+
+func newMockRelationRepo(t *testing.T) (*relationRepo, *sqlmockX.Mock) {
+	db, mock := sqlmockX.New(t)
+	return &relationRepo{
+		data: &Data{db: db},
+		log:  log.NewHelper(log.DefaultLogger),
+	}, mock
+}
+
+func TestRelationRepo_GetCommonFromDB(t *testing.T) {
+	repo, mock := newMockRelationRepo(t)
+	mock.ExpectQuery("FROM `followers`", &sqlmockX.Rows{
+		Columns: []string{"follower_id"},
+		Values:  [][]driver.Value{{int64(2)}, {int64(4)}},
+	})
+	common, err := repo.GetCommonFromDB(context.Background(), []uint32{2, 3, 4}, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint32{2, 4}, common)
+	selects := mock.Selects("followers")
+	assert.Len(t, selects, 1)
+	assert.Equal(t, []driver.Value{int64(1), int64(2), int64(3), int64(4)}, selects[0].Args)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/segmentio/kafka-go"
 
 	"github.com/toomanysource/atreus/app/relation/service/internal/conf"
+	"github.com/toomanysource/atreus/pkg/outboxX"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
@@ -26,9 +27,12 @@ var (
 	ErrMysqlDelete         = errors.New("mysql delete error")
 	ErrMysqlInsert         = errors.New("mysql insert error")
 	ErrMysqlQuery          = errors.New("mysql query error")
+	ErrMysqlUpdate         = errors.New("mysql update error")
 	ErrRedisDelete         = errors.New("redis delete error")
 	ErrRedisTransaction    = errors.New("redis transaction error")
 	ErrUserServiceResponse = errors.New("user service response error")
+
+	ErrMessageServiceResponse = errors.New("message service response error")
 )
 
 type KfkWriter struct {
@@ -40,6 +44,8 @@ type KfkWriter struct {
 type CacheClient struct {
 	followRelation   *redis.Client // 用户关注关系缓存
 	followedRelation *redis.Client // 用户被关注关系缓存
+	blockRelation    *redis.Client // 用户屏蔽和静音关系缓存
+	suggest          *redis.Client // 用户推荐列表缓存
 }
 
 type Data struct {
@@ -51,8 +57,13 @@ type Data struct {
 
 func NewData(db *gorm.DB, cache *CacheClient, kfk KfkWriter, logger log.Logger) (*Data, func(), error) {
 	logHelper := log.NewHelper(log.With(logger, "module", "data/data"))
+	// 发件箱中的计数变更事件由relay投递到Kafka
+	relay := outboxX.NewRelay(db, logger, kfk.follow, kfk.follower)
+	relay.Start()
 	// 关闭Redis连接
 	cleanup := func() {
+		// 先停止投递再关闭Kafka连接
+		relay.Stop()
 		var wg sync.WaitGroup
 		wg.Add(1)
 		go func() {
@@ -79,6 +90,30 @@ func NewData(db *gorm.DB, cache *CacheClient, kfk KfkWriter, logger log.Logger)
 			logHelper.Info("redis follow connection closure successfully")
 		}()
 		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := cache.blockRelation.Ping(context.Background()).Result()
+			if err != nil {
+				return
+			}
+			if err = cache.blockRelation.Close(); err != nil {
+				logHelper.Errorf("redis connection closure failed, err: %w", err)
+			}
+			logHelper.Info("redis block connection closure successfully")
+		}()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := cache.suggest.Ping(context.Background()).Result()
+			if err != nil {
+				return
+			}
+			if err = cache.suggest.Close(); err != nil {
+				logHelper.Errorf("redis connection closure failed, err: %w", err)
+			}
+			logHelper.Info("redis suggest connection closure successfully")
+		}()
+		wg.Add(1)
 		go func() {
 			defer wg.Done()
 			if err := kfk.follow.Close(); err != nil {
@@ -159,6 +194,40 @@ func NewRedisConn(c *conf.Data, l log.Logger) (cache *CacheClient) {
 			logs.Fatalf("redis database connection failure, err : %v", err)
 		}
 	}()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		cache.blockRelation = redis.NewClient(&redis.Options{
+			DB:           int(c.Redis.BlockDb),
+			Addr:         c.Redis.Addr,
+			WriteTimeout: c.Redis.WriteTimeout.AsDuration(),
+			ReadTimeout:  c.Redis.ReadTimeout.AsDuration(),
+			Password:     c.Redis.Password,
+		})
+
+		// ping Redis客户端，判断连接是否存在
+		_, err := cache.blockRelation.Ping(context.Background()).Result()
+		if err != nil {
+			logs.Fatalf("redis database connection failure, err : %v", err)
+		}
+	}()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		cache.suggest = redis.NewClient(&redis.Options{
+			DB:           int(c.Redis.SuggestDb),
+			Addr:         c.Redis.Addr,
+			WriteTimeout: c.Redis.WriteTimeout.AsDuration(),
+			ReadTimeout:  c.Redis.ReadTimeout.AsDuration(),
+			Password:     c.Redis.Password,
+		})
+
+		// ping Redis客户端，判断连接是否存在
+		_, err := cache.suggest.Ping(context.Background()).Result()
+		if err != nil {
+			logs.Fatalf("redis database connection failure, err : %v", err)
+		}
+	}()
 	wg.Wait()
 	logs.Info("cache enabled successfully")
 	return
@@ -183,9 +252,12 @@ func NewKafkaWriter(c *conf.Data, l log.Logger) KfkWriter {
 	}
 }
 
-// InitDB 创建followers数据表，并自动迁移
+// InitDB 创建followers、blocks、follow_requests和发件箱数据表，并自动迁移
 func InitDB(db *gorm.DB) {
-	if err := db.AutoMigrate(&Followers{}); err != nil {
+	if err := db.AutoMigrate(&Followers{}, &Block{}, &FollowRequest{}); err != nil {
 		log.Fatalf("database initialization error, err : %v", err)
 	}
+	if err := outboxX.InitDB(db); err != nil {
+		log.Fatalf("outbox initialization error, err : %v", err)
+	}
 }
@@ -0,0 +1,41 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// DeduplicateFollowers 删除重复的关注关系，每组重复关系只保留id最小的一条，返回删除的行数
+func DeduplicateFollowers(ctx context.Context, db *gorm.DB) (int64, error) {
+	result := db.WithContext(ctx).Exec(
+		"DELETE f1 FROM followers f1 JOIN followers f2 " +
+			"ON f1.user_id = f2.user_id AND f1.follower_id = f2.follower_id AND f1.id > f2.id")
+	if result.Error != nil {
+		return 0, errors.Join(ErrMysqlDelete, result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// RecomputeFollowCounters 根据关注关系重新计算用户的关注数和粉丝数
+func RecomputeFollowCounters(ctx context.Context, db *gorm.DB) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		stmts := []string{
+			// 用户的关注数量
+			"UPDATE users u LEFT JOIN " +
+				"(SELECT follower_id, COUNT(*) AS cnt FROM followers GROUP BY follower_id) f " +
+				"ON u.id = f.follower_id SET u.follow_count = COALESCE(f.cnt, 0)",
+			// 用户的粉丝数量
+			"UPDATE users u LEFT JOIN " +
+				"(SELECT user_id, COUNT(*) AS cnt FROM followers GROUP BY user_id) f " +
+				"ON u.id = f.user_id SET u.follower_count = COALESCE(f.cnt, 0)",
+		}
+		for _, stmt := range stmts {
+			if err := tx.Exec(stmt).Error; err != nil {
+				return errors.Join(ErrMysqlUpdate, err)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,228 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// 关注关系缓存的key前缀
+const (
+	followKeyPrefix   = "follow:"   // 用户关注的用户，哈希表
+	followerKeyPrefix = "follower:" // 用户的粉丝，有序集合
+)
+
+// FollowerCacheLimit 每个用户最多缓存的最新粉丝数量，更早的粉丝分页时从数据库读取
+const FollowerCacheLimit = 1000
+
+// 粉丝缓存中占位成员的分数，粉丝的分数为关注关系的自增id，与关注先后一致且始终为正数
+const (
+	followerComplete float64 = -1 // 缓存包含全部粉丝
+	followerPartial  float64 = -2 // 缓存只包含最新的FollowerCacheLimit个粉丝
+)
+
+func followKey(userId uint32) string {
+	return followKeyPrefix + strconv.Itoa(int(userId))
+}
+
+func followerKey(userId uint32) string {
+	return followerKeyPrefix + strconv.Itoa(int(userId))
+}
+
+// getFollowerPage 按关注时间从新到旧获取粉丝关系，cursor为上一页最后一条关系的id，0表示第一页。
+// 优先从缓存读取，缓存只包含部分粉丝且不足一页时从数据库补齐，缓存不存在时只加载最新的部分粉丝
+func (r *relationRepo) getFollowerPage(ctx context.Context, userId, cursor uint32, count int) ([]*Followers, error) {
+	key := followerKey(userId)
+	state, err := r.data.cache.followedRelation.ZScore(ctx, key, OccupyKey).Result()
+	if errors.Is(err, redis.Nil) {
+		return r.loadFollowerPage(ctx, userId, cursor, count)
+	}
+	if err != nil {
+		return nil, errors.Join(ErrRedisQuery, err)
+	}
+	maxScore := "+inf"
+	if cursor != 0 {
+		maxScore = "(" + strconv.Itoa(int(cursor))
+	}
+	values, err := r.data.cache.followedRelation.ZRevRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{
+		Min:   "0",
+		Max:   maxScore,
+		Count: int64(count),
+	}).Result()
+	if err != nil {
+		return nil, errors.Join(ErrRedisQuery, err)
+	}
+	page := make([]*Followers, 0, count)
+	for _, v := range values {
+		followerId, err := strconv.Atoi(v.Member.(string))
+		if err != nil {
+			return nil, err
+		}
+		page = append(page, &Followers{Id: uint32(v.Score), UserId: userId, FollowerId: uint32(followerId)})
+	}
+	if len(page) == count || state == followerComplete {
+		return page, nil
+	}
+	// 缓存中的粉丝已读完，更早的粉丝从数据库读取
+	if len(page) > 0 {
+		cursor = page[len(page)-1].Id
+	}
+	rest, err := r.GetFollowerPageFromDB(ctx, userId, cursor, count-len(page))
+	if err != nil {
+		return nil, err
+	}
+	return append(page, rest...), nil
+}
+
+// loadFollowerPage 缓存不存在时从数据库获取一页粉丝，并异步将最新的粉丝存入缓存
+func (r *relationRepo) loadFollowerPage(ctx context.Context, userId, cursor uint32, count int) ([]*Followers, error) {
+	// 多读取一条用于判断是否还有更早的粉丝
+	latest, err := r.GetFollowerPageFromDB(ctx, userId, 0, FollowerCacheLimit+1)
+	if err != nil {
+		return nil, err
+	}
+	state := followerComplete
+	if len(latest) > FollowerCacheLimit {
+		latest = latest[:FollowerCacheLimit]
+		state = followerPartial
+	}
+	go func() {
+		if err := r.CreateFollowerCache(context.Background(), userId, latest, state); err != nil {
+			r.log.Error(err)
+			return
+		}
+		r.log.Info("redis transaction success")
+	}()
+	page := make([]*Followers, 0, count)
+	for _, v := range latest {
+		if cursor != 0 && v.Id >= cursor {
+			continue
+		}
+		page = append(page, v)
+		if len(page) == count {
+			return page, nil
+		}
+	}
+	if state == followerComplete {
+		return page, nil
+	}
+	// 缓存的粉丝中没有满足条件的说明cursor早于它们，直接从cursor继续读取
+	if len(page) > 0 {
+		cursor = page[len(page)-1].Id
+	}
+	rest, err := r.GetFollowerPageFromDB(ctx, userId, cursor, count-len(page))
+	if err != nil {
+		return nil, err
+	}
+	return append(page, rest...), nil
+}
+
+// GetFollowerPageFromDB 数据库按关注时间从新到旧获取id小于cursor的粉丝关系，cursor为0时从最新的开始
+func (r *relationRepo) GetFollowerPageFromDB(ctx context.Context, userId, cursor uint32, count int) ([]*Followers, error) {
+	var followers []*Followers
+	db := r.data.db.WithContext(ctx).Where("user_id = ?", userId)
+	if cursor != 0 {
+		db = db.Where("id < ?", cursor)
+	}
+	if err := db.Order("id desc").Limit(count).Find(&followers).Error; err != nil {
+		return nil, errors.Join(ErrMysqlQuery, err)
+	}
+	return followers, nil
+}
+
+// CreateFollowerCache 使用有序集合缓存粉丝，state标记缓存是否包含全部粉丝
+func (r *relationRepo) CreateFollowerCache(
+	ctx context.Context, userId uint32, followers []*Followers, state float64,
+) error {
+	key := followerKey(userId)
+	_, err := r.data.cache.followedRelation.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		members := make([]*redis.Z, 0, len(followers)+1)
+		members = append(members, &redis.Z{Score: state, Member: OccupyKey})
+		for _, v := range followers {
+			members = append(members, &redis.Z{Score: float64(v.Id), Member: strconv.Itoa(int(v.FollowerId))})
+		}
+		if err := pipe.Del(ctx, key).Err(); err != nil {
+			return errors.Join(ErrRedisDelete, err)
+		}
+		if err := pipe.ZAdd(ctx, key, members...).Err(); err != nil {
+			return errors.Join(ErrRedisSet, err)
+		}
+		// 使用随机过期时间防止缓存雪崩
+		begin, end := 360, 720
+		if err := pipe.Expire(ctx, key, randomTime(time.Minute, begin, end)).Err(); err != nil {
+			return errors.Join(ErrRedisSet, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Join(ErrRedisTransaction, err)
+	}
+	return nil
+}
+
+// AddFollowerCache 将新的粉丝加入已存在的粉丝缓存，超出缓存数量时淘汰最早的粉丝。
+// 缓存不存在时不创建，等到读取时再加载
+func (r *relationRepo) AddFollowerCache(ctx context.Context, toUserId, userId, followId uint32) error {
+	key := followerKey(toUserId)
+	count, err := r.data.cache.followedRelation.Exists(ctx, key).Result()
+	if err != nil {
+		return errors.Join(ErrRedisQuery, err)
+	}
+	if count == 0 {
+		return nil
+	}
+	var removed *redis.IntCmd
+	_, err = r.data.cache.followedRelation.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, key, &redis.Z{Score: float64(followId), Member: strconv.Itoa(int(userId))})
+		// 排名0为占位成员，只保留分数最高的FollowerCacheLimit个粉丝
+		removed = pipe.ZRemRangeByRank(ctx, key, 1, -(FollowerCacheLimit + 1))
+		return nil
+	})
+	if err != nil {
+		return errors.Join(ErrRedisTransaction, err)
+	}
+	if removed.Val() == 0 {
+		return nil
+	}
+	// 淘汰了粉丝后缓存不再包含全部粉丝
+	err = r.data.cache.followedRelation.ZAddXX(ctx, key, &redis.Z{Score: followerPartial, Member: OccupyKey}).Err()
+	if err != nil {
+		return errors.Join(ErrRedisSet, err)
+	}
+	return nil
+}
+
+// DeleteFollowerCache 从粉丝缓存中删除粉丝
+func (r *relationRepo) DeleteFollowerCache(ctx context.Context, toUserId, userId uint32) error {
+	err := r.data.cache.followedRelation.ZRem(ctx, followerKey(toUserId), strconv.Itoa(int(userId))).Err()
+	if err != nil {
+		return errors.Join(ErrRedisDelete, err)
+	}
+	return nil
+}
+
+// getFollowerScores 从包含全部粉丝的缓存中判断ids中的用户是否关注了userId，缓存不存在或只包含部分粉丝时返回nil
+func (r *relationRepo) getFollowerScores(ctx context.Context, userId uint32, ids []uint32) ([]bool, error) {
+	key := followerKey(userId)
+	members := make([]string, 0, len(ids)+1)
+	members = append(members, OccupyKey)
+	for _, id := range ids {
+		members = append(members, strconv.Itoa(int(id)))
+	}
+	scores, err := r.data.cache.followedRelation.ZMScore(ctx, key, members...).Result()
+	if err != nil {
+		return nil, errors.Join(ErrRedisQuery, err)
+	}
+	if scores[0] != followerComplete {
+		return nil, nil
+	}
+	oks := make([]bool, 0, len(ids))
+	for _, score := range scores[1:] {
+		// 不存在的成员分数为0
+		oks = append(oks, score > 0)
+	}
+	return oks, nil
+}
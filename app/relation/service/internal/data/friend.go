@@ -0,0 +1,154 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/toomanysource/atreus/middleware"
+
+	"github.com/toomanysource/atreus/app/relation/service/internal/biz"
+)
+
+// friendKeyPrefix 好友缓存的key前缀，有序集合，分数为成为好友时的关注关系id
+const friendKeyPrefix = "friend:"
+
+// mutualFollowFriendQuery 互相关注的好友查询语句，参数为用户id，按成为好友的先后从新到旧排列
+var mutualFollowFriendQuery = "SELECT f1.user_id AS id, GREATEST(f1.id, f2.id) AS `cursor` FROM followers f1 " +
+	"JOIN followers f2 ON f2.user_id = f1.follower_id AND f2.follower_id = f1.user_id " +
+	"WHERE f1.follower_id = ? ORDER BY `cursor` DESC"
+
+// friendRow 好友查询结果，Cursor为成为好友时的关注关系id
+type friendRow struct {
+	Id     uint32
+	Cursor uint32
+}
+
+func friendKey(userId uint32) string {
+	return friendKeyPrefix + strconv.Itoa(int(userId))
+}
+
+// GetFriendList 获取与用户互相关注的好友列表，按成为好友的时间从新到旧排列
+func (r *relationRepo) GetFriendList(ctx context.Context, userId uint32) ([]*biz.User, error) {
+	userID := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	ids, err := r.getFriendIds(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	users, err := r.userRepo.GetUserInfos(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	isFollow := make([]bool, len(ids))
+	if userID == userId {
+		// 好友都是自己关注的用户
+		for i := range isFollow {
+			isFollow[i] = true
+		}
+	} else {
+		isFollow, err = r.SearchRelation(ctx, userID, ids)
+		if err != nil {
+			return nil, err
+		}
+	}
+	userMap := make(map[uint32]*biz.User, len(users))
+	for _, user := range users {
+		userMap[user.Id] = user
+	}
+	sorted := make([]*biz.User, 0, len(ids))
+	for i, id := range ids {
+		if user, ok := userMap[id]; ok {
+			user.IsFollow = isFollow[i]
+			sorted = append(sorted, user)
+		}
+	}
+	r.log.Infof(
+		"GetFriendUserList -> userId: %v - friendCount: %v", userId, len(sorted))
+	return sorted, nil
+}
+
+// getFriendIds 获取好友id，缓存不存在时从数据库查询并创建缓存
+func (r *relationRepo) getFriendIds(ctx context.Context, userId uint32) ([]uint32, error) {
+	key := friendKey(userId)
+	values, err := r.data.cache.followRelation.ZRevRange(ctx, key, 0, -1).Result()
+	if err != nil {
+		return nil, errors.Join(ErrRedisQuery, err)
+	}
+	if len(values) > 0 {
+		ids := make([]uint32, 0, len(values))
+		for _, v := range values {
+			if v == OccupyKey {
+				continue
+			}
+			id, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, uint32(id))
+		}
+		return ids, nil
+	}
+	friends, err := r.GetFriendsFromDB(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	// 将好友列表存入redis缓存，没有好友时也会缓存占位成员
+	go func() {
+		if err := r.CreateFriendCache(context.Background(), key, friends); err != nil {
+			r.log.Error(err)
+			return
+		}
+		r.log.Info("redis transaction success")
+	}()
+	ids := make([]uint32, 0, len(friends))
+	for _, v := range friends {
+		ids = append(ids, v.Id)
+	}
+	return ids, nil
+}
+
+// GetFriendsFromDB 数据库自连接查询互相关注的好友
+func (r *relationRepo) GetFriendsFromDB(ctx context.Context, userId uint32) ([]*friendRow, error) {
+	var friends []*friendRow
+	if err := r.data.db.WithContext(ctx).Raw(mutualFollowFriendQuery, userId).Scan(&friends).Error; err != nil {
+		return nil, errors.Join(ErrMysqlQuery, err)
+	}
+	return friends, nil
+}
+
+// CreateFriendCache 使用有序集合缓存好友列表
+func (r *relationRepo) CreateFriendCache(ctx context.Context, key string, friends []*friendRow) error {
+	_, err := r.data.cache.followRelation.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		members := make([]*redis.Z, 0, len(friends)+1)
+		members = append(members, &redis.Z{Score: -1, Member: OccupyKey})
+		for _, v := range friends {
+			members = append(members, &redis.Z{Score: float64(v.Cursor), Member: strconv.Itoa(int(v.Id))})
+		}
+		if err := pipe.ZAdd(ctx, key, members...).Err(); err != nil {
+			return errors.Join(ErrRedisSet, err)
+		}
+		begin, end := 360, 720
+		if err := pipe.Expire(ctx, key, randomTime(time.Minute, begin, end)).Err(); err != nil {
+			return errors.Join(ErrRedisSet, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Join(ErrRedisTransaction, err)
+	}
+	return nil
+}
+
+// DeleteFriendCache 关注关系变化后删除双方的好友缓存，下次查询时重新创建
+func (r *relationRepo) DeleteFriendCache(ctx context.Context, userId, toUserId uint32) error {
+	if err := r.data.cache.followRelation.Del(ctx, friendKey(userId), friendKey(toUserId)).Err(); err != nil {
+		return errors.Join(ErrRedisDelete, err)
+	}
+	return nil
+}
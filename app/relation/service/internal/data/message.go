@@ -0,0 +1,51 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/toomanysource/atreus/api/message/service/v1"
+	"github.com/toomanysource/atreus/app/relation/service/internal/biz"
+)
+
+// encryptedContentType message服务中端到端加密消息的类型，内容为密文，不在好友列表中展示
+const encryptedContentType uint32 = 4
+
+type MessageRepo interface {
+	GetLatestMessages(ctx context.Context, userId uint32, toUserIds []uint32) ([]*biz.FriendMessage, error)
+}
+
+type messageRepo struct {
+	client pb.MessageServiceClient
+}
+
+func NewMessageRepo(conn pb.MessageServiceClient) MessageRepo {
+	return &messageRepo{
+		client: conn,
+	}
+}
+
+// GetLatestMessages 接收Message服务的回应，并转化为biz.FriendMessage类型
+func (m *messageRepo) GetLatestMessages(
+	ctx context.Context, userId uint32, toUserIds []uint32,
+) ([]*biz.FriendMessage, error) {
+	resp, err := m.client.GetLatestMessages(ctx, &pb.LatestMessagesRequest{UserId: userId, ToUserIds: toUserIds})
+	if err != nil {
+		return nil, errors.Join(ErrMessageServiceResponse, err)
+	}
+	messages := make([]*biz.FriendMessage, 0, len(resp.Messages))
+	for _, v := range resp.Messages {
+		content := v.GetMessage().GetContent()
+		if v.GetMessage().GetContentType() == encryptedContentType {
+			content = ""
+		}
+		messages = append(messages, &biz.FriendMessage{
+			ToUserId:    v.ToUserId,
+			Content:     content,
+			MsgType:     v.MsgType,
+			UnreadCount: v.UnreadCount,
+			CreateTime:  v.GetMessage().GetCreateTime(),
+		})
+	}
+	return messages, nil
+}
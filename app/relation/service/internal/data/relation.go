@@ -7,13 +7,15 @@ import (
 	"strconv"
 	"time"
 
+	messagev1 "github.com/toomanysource/atreus/api/message/service/v1"
 	userv1 "github.com/toomanysource/atreus/api/user/service/v1"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/toomanysource/atreus/middleware"
 
-	"github.com/toomanysource/atreus/pkg/kafkaX"
+	"github.com/toomanysource/atreus/pkg/outboxX"
 
 	"github.com/toomanysource/atreus/app/relation/service/internal/biz"
 
@@ -27,19 +29,20 @@ const (
 )
 
 var (
-	ErrFollowYourself   = errors.New("can't follow yourself")
-	ErrExistRelation    = errors.New("exist relation")
-	ErrNotExistRelation = errors.New("not exist relation")
+	ErrFollowYourself = errors.New("can't follow yourself")
+	ErrBlockYourself  = errors.New("can't block yourself")
+	ErrBlocked        = errors.New("user is blocked")
 )
 
 type UserRepo interface {
 	GetUserInfos(ctx context.Context, userId uint32, userIds []uint32) ([]*biz.User, error)
+	IsPrivate(ctx context.Context, userId uint32) (bool, error)
 }
 
 type Followers struct {
 	Id         uint32 `gorm:"primary_key"`
-	UserId     uint32 `gorm:"column:user_id;not null;index:idx_user_id"`
-	FollowerId uint32 `gorm:"column:follower_id;not null;index:idx_follower_id"`
+	UserId     uint32 `gorm:"column:user_id;not null;index:idx_user_id;uniqueIndex:uk_user_follower"`
+	FollowerId uint32 `gorm:"column:follower_id;not null;index:idx_follower_id;uniqueIndex:uk_user_follower"`
 }
 
 func (Followers) TableName() string {
@@ -47,31 +50,70 @@ func (Followers) TableName() string {
 }
 
 type relationRepo struct {
-	data     *Data
-	kfk      KfkWriter
-	userRepo UserRepo
-	log      *log.Helper
+	data        *Data
+	kfk         KfkWriter
+	userRepo    UserRepo
+	messageRepo MessageRepo
+	log         *log.Helper
 }
 
-func NewRelationRepo(data *Data, userConn userv1.UserServiceClient, logger log.Logger) biz.RelationRepo {
+func NewRelationRepo(
+	data *Data, userConn userv1.UserServiceClient, messageConn messagev1.MessageServiceClient, logger log.Logger,
+) biz.RelationRepo {
 	return &relationRepo{
-		data:     data,
-		kfk:      data.kfk,
-		userRepo: NewUserRepo(userConn),
-		log:      log.NewHelper(logger),
+		data:        data,
+		kfk:         data.kfk,
+		userRepo:    NewUserRepo(userConn),
+		messageRepo: NewMessageRepo(messageConn),
+		log:         log.NewHelper(logger),
 	}
 }
 
-// Follow 关注
-func (r *relationRepo) Follow(ctx context.Context, toUserId uint32) error {
+// GetLatestMessages 通过Message服务获取用户与toUserIds中每个用户的最新消息和未读消息数量
+func (r *relationRepo) GetLatestMessages(
+	ctx context.Context, userId uint32, toUserIds []uint32,
+) ([]*biz.FriendMessage, error) {
+	return r.messageRepo.GetLatestMessages(ctx, userId, toUserIds)
+}
+
+// Follow 关注，返回关注状态是否发生变化
+func (r *relationRepo) Follow(ctx context.Context, toUserId uint32) (bool, error) {
 	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	if err := r.checkFollow(ctx, userId, toUserId); err != nil {
+		return false, err
+	}
+	// 先在数据库中插入关系
+	followId, err := r.AddFollow(ctx, userId, toUserId)
+	if err != nil || followId == 0 {
+		return false, err
+	}
+	r.addFollowCaches(userId, toUserId, followId)
+	r.log.Infof(
+		"CreateRelation -> userId: %v - toUserId: %v", userId, toUserId)
+	return true, nil
+}
+
+// checkFollow 检查userId能否关注toUserId，不能关注自己，任意一方屏蔽了对方时也不能关注
+func (r *relationRepo) checkFollow(ctx context.Context, userId, toUserId uint32) error {
 	if userId == toUserId {
 		return ErrFollowYourself
 	}
-	// 先在数据库中插入关系
-	if err := r.AddFollow(ctx, userId, toUserId); err != nil {
+	blocked, err := r.isBlockedEither(ctx, userId, toUserId)
+	if err != nil {
 		return err
 	}
+	if blocked {
+		return ErrBlocked
+	}
+	return nil
+}
+
+// addFollowCaches 将新的关注关系写入关注和粉丝缓存，followId为关注关系的id，
+// 双方的好友缓存同步删除，关注和粉丝缓存异步更新
+func (r *relationRepo) addFollowCaches(userId, toUserId, followId uint32) {
+	if err := r.DeleteFriendCache(context.TODO(), userId, toUserId); err != nil {
+		r.log.Error(err)
+	}
 	go func() {
 		ctx := context.TODO()
 		if err := r.AddFollowCache(ctx, userId, toUserId); err != nil {
@@ -82,23 +124,34 @@ func (r *relationRepo) Follow(ctx context.Context, toUserId uint32) error {
 	}()
 	go func() {
 		ctx := context.TODO()
-		if err := r.AddFollowedCache(ctx, toUserId, userId); err != nil {
+		if err := r.AddFollowerCache(ctx, toUserId, userId, followId); err != nil {
 			r.log.Error(err)
 			return
 		}
 		r.log.Info("redis store success")
 	}()
-	r.log.Infof(
-		"CreateRelation -> userId: %v - toUserId: %v", userId, toUserId)
-	return nil
 }
 
-// UnFollow 取消关注
-func (r *relationRepo) UnFollow(ctx context.Context, toUserId uint32) error {
+// UnFollow 取消关注，返回关注状态是否发生变化
+func (r *relationRepo) UnFollow(ctx context.Context, toUserId uint32) (bool, error) {
 	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
-	err := r.DelFollow(ctx, userId, toUserId)
-	if err != nil {
-		return err
+	return r.removeFollow(ctx, userId, toUserId)
+}
+
+// removeFollow 删除userId对toUserId的关注关系并更新缓存，返回关注状态是否发生变化
+func (r *relationRepo) removeFollow(ctx context.Context, userId, toUserId uint32) (bool, error) {
+	changed, err := r.DelFollow(ctx, userId, toUserId)
+	if err != nil || !changed {
+		return false, err
+	}
+	r.removeFollowCaches(ctx, userId, toUserId)
+	return true, nil
+}
+
+// removeFollowCaches 关注关系删除后更新双方的好友、关注和粉丝缓存
+func (r *relationRepo) removeFollowCaches(ctx context.Context, userId, toUserId uint32) {
+	if err := r.DeleteFriendCache(ctx, userId, toUserId); err != nil {
+		r.log.Error(err)
 	}
 	go func() {
 		ctx := context.TODO()
@@ -117,29 +170,17 @@ func (r *relationRepo) UnFollow(ctx context.Context, toUserId uint32) error {
 		}
 	}()
 	go func() {
-		ctx := context.TODO()
-		// 在redis缓存中查询是否存在
-		ok, err := r.CheckFollowedCache(ctx, toUserId, userId)
-		if err != nil {
+		if err := r.DeleteFollowerCache(context.TODO(), toUserId, userId); err != nil {
 			r.log.Error(err)
-			return
-		}
-		if ok {
-			// 如果存在则删除
-			if err = r.DeleteFollowedCache(ctx, toUserId, userId); err != nil {
-				r.log.Error(err)
-				return
-			}
 		}
 	}()
 	r.log.Infof(
 		"DelRelation -> userId: %v - toUserId: %v", userId, toUserId)
-	return nil
 }
 
 // IsFollow 查询是否关注
 func (r *relationRepo) IsFollow(ctx context.Context, userId uint32, toUserId []uint32) (oks []bool, err error) {
-	count, err := r.data.cache.followRelation.Exists(ctx, strconv.Itoa(int(userId))).Result()
+	count, err := r.data.cache.followRelation.Exists(ctx, followKey(userId)).Result()
 	if err != nil {
 		return nil, errors.Join(ErrRedisQuery, err)
 	}
@@ -179,38 +220,10 @@ func (r *relationRepo) IsFollow(ctx context.Context, userId uint32, toUserId []u
 // GetFollowList 获取关注列表
 func (r *relationRepo) GetFollowList(ctx context.Context, userId uint32) ([]*biz.User, error) {
 	userID := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
-	// 先在redis缓存中查询是否存在关注列表
-	follows, err := r.GetFollowCache(ctx, userId)
+	fl, err := r.getFollowIds(ctx, userId)
 	if err != nil {
 		return nil, err
 	}
-	fl := make([]uint32, 0, len(follows))
-	if len(follows) > 0 {
-		for _, v := range follows {
-			if v == OccupyKey {
-				continue
-			}
-			vc, err := strconv.Atoi(v)
-			if err != nil {
-				return nil, err
-			}
-			fl = append(fl, uint32(vc))
-		}
-	} else {
-		// 如果不存在则创建
-		fl, err = r.GetFlList(ctx, userId)
-		if err != nil {
-			return nil, err
-		}
-		// 将关注列表存入redis缓存
-		go func(l []uint32) {
-			if err = CreateCacheByTran(context.Background(), r.data.cache.followRelation, l, userId); err != nil {
-				r.log.Error(err)
-				return
-			}
-			r.log.Info("redis transaction success")
-		}(fl)
-	}
 	if len(fl) == 0 {
 		return nil, nil
 	}
@@ -243,17 +256,16 @@ func (r *relationRepo) GetFollowList(ctx context.Context, userId uint32) ([]*biz
 	return users, nil
 }
 
-// GetFollowerList 获取粉丝列表
-func (r *relationRepo) GetFollowerList(ctx context.Context, userId uint32) (ul []*biz.User, err error) {
-	// 先在redis缓存中查询是否存在被关注列表
-	userID := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
-	followers, err := r.GetFollowedCache(ctx, userId)
+// getFollowIds 获取用户关注的用户id，缓存不存在时从数据库查询并创建缓存
+func (r *relationRepo) getFollowIds(ctx context.Context, userId uint32) ([]uint32, error) {
+	// 先在redis缓存中查询是否存在关注列表
+	follows, err := r.GetFollowCache(ctx, userId)
 	if err != nil {
 		return nil, err
 	}
-	fl := make([]uint32, 0, len(followers))
-	if len(followers) > 0 {
-		for _, v := range followers {
+	if len(follows) > 0 {
+		fl := make([]uint32, 0, len(follows))
+		for _, v := range follows {
 			if v == OccupyKey {
 				continue
 			}
@@ -263,61 +275,74 @@ func (r *relationRepo) GetFollowerList(ctx context.Context, userId uint32) (ul [
 			}
 			fl = append(fl, uint32(vc))
 		}
-	} else {
-		// 如果不存在则创建
-		fl, err = r.GetFlrList(ctx, userId)
-		if err != nil {
-			return nil, err
-		}
-		// 将关注列表存入redis缓存
-		go func(l []uint32) {
-			if err = CreateCacheByTran(context.Background(), r.data.cache.followedRelation, l, userId); err != nil {
-				r.log.Error(err)
-				return
-			}
-			r.log.Info("redis transaction success")
-		}(fl)
+		return fl, nil
 	}
-	if len(fl) == 0 {
-		return nil, nil
+	// 如果不存在则创建
+	fl, err := r.GetFlList(ctx, userId)
+	if err != nil {
+		return nil, err
 	}
-	if userID != userId {
-		// 查询是否关注
-		isFollow, err := r.SearchRelation(ctx, userID, fl)
-		if err != nil {
-			return nil, err
-		}
-		users, err := r.userRepo.GetUserInfos(ctx, userID, fl)
-		if err != nil {
-			return nil, err
-		}
-		for i, user := range users {
-			user.IsFollow = isFollow[i]
+	// 将关注列表存入redis缓存
+	go func(l []uint32) {
+		if err := CreateCacheByTran(context.Background(), r.data.cache.followRelation, l, userId); err != nil {
+			r.log.Error(err)
+			return
 		}
-		r.log.Infof(
-			"GetFollowUserList -> userId: %v - userList: %v", userId, users)
-		return users, nil
+		r.log.Info("redis transaction success")
+	}(fl)
+	return fl, nil
+}
+
+// GetFollowerList 按关注时间从新到旧分页获取粉丝列表，cursor为上一页返回的游标，0表示第一页，
+// 返回下一页的游标，没有更多粉丝时为0
+func (r *relationRepo) GetFollowerList(
+	ctx context.Context, userId, cursor uint32, count int,
+) ([]*biz.User, uint32, error) {
+	userID := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	followers, err := r.getFollowerPage(ctx, userId, cursor, count)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(followers) == 0 {
+		return nil, 0, nil
+	}
+	fl := make([]uint32, 0, len(followers))
+	for _, v := range followers {
+		fl = append(fl, v.FollowerId)
 	}
-	// 查询是否关注粉丝
-	isFollow, err := r.SearchRelation(ctx, userId, fl)
+	// 查询当前用户是否关注了这些粉丝
+	isFollow, err := r.SearchRelation(ctx, userID, fl)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	users, err := r.userRepo.GetUserInfos(ctx, userId, fl)
+	users, err := r.userRepo.GetUserInfos(ctx, userID, fl)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+	// 按关注时间排序返回
+	userMap := make(map[uint32]*biz.User, len(users))
+	for _, user := range users {
+		userMap[user.Id] = user
 	}
-	for i, user := range users {
-		user.IsFollow = isFollow[i]
+	sorted := make([]*biz.User, 0, len(fl))
+	for i, id := range fl {
+		if user, ok := userMap[id]; ok {
+			user.IsFollow = isFollow[i]
+			sorted = append(sorted, user)
+		}
+	}
+	var next uint32
+	if len(followers) == count {
+		next = followers[len(followers)-1].Id
 	}
 	r.log.Infof(
-		"GetFollowUserList -> userId: %v - userList: %v", userId, users)
-	return users, nil
+		"GetFollowerUserList -> userId: %v - cursor: %v - next: %v", userId, cursor, next)
+	return sorted, next, nil
 }
 
 // GetFollowCache 获取关注缓存
 func (r *relationRepo) GetFollowCache(ctx context.Context, userId uint32) ([]string, error) {
-	follows, err := r.data.cache.followRelation.HKeys(ctx, strconv.Itoa(int(userId))).Result()
+	follows, err := r.data.cache.followRelation.HKeys(ctx, followKey(userId)).Result()
 	if err != nil {
 		return nil, errors.Join(ErrRedisQuery, err)
 	}
@@ -348,7 +373,7 @@ func (r *relationRepo) AddFollowCache(ctx context.Context, userId uint32, toUser
 
 // CheckFollowCache 查询关注缓存
 func (r *relationRepo) CheckFollowCache(ctx context.Context, userId uint32, toUserId uint32) (bool, error) {
-	ok, err := r.data.cache.followRelation.HExists(ctx, strconv.Itoa(int(userId)), strconv.Itoa(int(toUserId))).Result()
+	ok, err := r.data.cache.followRelation.HExists(ctx, followKey(userId), strconv.Itoa(int(toUserId))).Result()
 	if err != nil {
 		return false, errors.Join(ErrRedisQuery, err)
 	}
@@ -358,7 +383,7 @@ func (r *relationRepo) CheckFollowCache(ctx context.Context, userId uint32, toUs
 // CreateFollowCache 创建关注缓存
 func (r *relationRepo) CreateFollowCache(ctx context.Context, userId uint32, toUserId uint32) (err error) {
 	if err = r.data.cache.followRelation.HSet(
-		ctx, strconv.Itoa(int(userId)), strconv.Itoa(int(toUserId)), "").Err(); err != nil {
+		ctx, followKey(userId), strconv.Itoa(int(toUserId)), "").Err(); err != nil {
 		return errors.Join(ErrRedisSet, err)
 	}
 	return nil
@@ -366,60 +391,7 @@ func (r *relationRepo) CreateFollowCache(ctx context.Context, userId uint32, toU
 
 // DeleteFollowCache 删除关注缓存
 func (r *relationRepo) DeleteFollowCache(ctx context.Context, userId uint32, toUserId uint32) error {
-	if err := r.data.cache.followRelation.HDel(ctx, strconv.Itoa(int(userId)), strconv.Itoa(int(toUserId))).Err(); err != nil {
-		return errors.Join(ErrRedisDelete, err)
-	}
-	return nil
-}
-
-// GetFollowedCache 获取粉丝缓存
-func (r *relationRepo) GetFollowedCache(ctx context.Context, userId uint32) ([]string, error) {
-	followers, err := r.data.cache.followedRelation.HKeys(ctx, strconv.Itoa(int(userId))).Result()
-	if err != nil {
-		return nil, errors.Join(ErrRedisQuery, err)
-	}
-	return followers, nil
-}
-
-// AddFollowedCache 添加粉丝缓存
-func (r *relationRepo) AddFollowedCache(ctx context.Context, toUserId uint32, userId uint32) (err error) {
-	// 在redis缓存中查询是否存在
-	ok, err := r.CheckFollowedCache(ctx, toUserId, userId)
-	if err != nil {
-		return err
-	}
-	if !ok {
-		// 如果不存在则创建
-		cl, err := r.GetFlrList(ctx, toUserId)
-		if err != nil {
-			return err
-		}
-		return CreateCacheByTran(ctx, r.data.cache.followedRelation, cl, toUserId)
-	}
-	return r.CreateFollowedCache(ctx, toUserId, userId)
-}
-
-// CheckFollowedCache 查询粉丝缓存
-func (r *relationRepo) CheckFollowedCache(ctx context.Context, toUserId uint32, userId uint32) (bool, error) {
-	ok, err := r.data.cache.followedRelation.HExists(ctx, strconv.Itoa(int(toUserId)), strconv.Itoa(int(userId))).Result()
-	if err != nil {
-		return false, errors.Join(ErrRedisQuery, err)
-	}
-	return ok, nil
-}
-
-// CreateFollowedCache 创建粉丝缓存
-func (r *relationRepo) CreateFollowedCache(ctx context.Context, toUserId uint32, userId uint32) (err error) {
-	if err = r.data.cache.followedRelation.HSet(
-		ctx, strconv.Itoa(int(toUserId)), strconv.Itoa(int(userId)), "").Err(); err != nil {
-		return errors.Join(ErrRedisSet, err)
-	}
-	return nil
-}
-
-// DeleteFollowedCache 删除粉丝缓存
-func (r *relationRepo) DeleteFollowedCache(ctx context.Context, toUserId uint32, userId uint32) error {
-	if err := r.data.cache.followedRelation.HDel(ctx, strconv.Itoa(int(toUserId)), strconv.Itoa(int(userId))).Err(); err != nil {
+	if err := r.data.cache.followRelation.HDel(ctx, followKey(userId), strconv.Itoa(int(toUserId))).Err(); err != nil {
 		return errors.Join(ErrRedisDelete, err)
 	}
 	return nil
@@ -440,85 +412,71 @@ func (r *relationRepo) GetFlList(ctx context.Context, userId uint32) (userIDs []
 	return
 }
 
-// GetFlrList 数据库获取粉丝列表
-func (r *relationRepo) GetFlrList(ctx context.Context, userId uint32) ([]uint32, error) {
-	var followers []*Followers
-	if err := r.data.db.WithContext(ctx).Where("user_id = ?", userId).Find(&followers).Error; err != nil {
-		return nil, errors.Join(ErrMysqlQuery, err)
-	}
-	if len(followers) == 0 {
-		return nil, nil
-	}
-	userIDs := make([]uint32, 0, len(followers))
-	for _, follower := range followers {
-		userIDs = append(userIDs, follower.FollowerId)
+// AddFollow 数据库添加关注关系，已存在时不做任何修改，返回新关系的id，未插入新关系时为0。
+// 计数变更事件与关注关系在同一事务中写入发件箱
+func (r *relationRepo) AddFollow(ctx context.Context, userId uint32, toUserId uint32) (uint32, error) {
+	var followId uint32
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) (err error) {
+		followId, err = r.addFollowTx(tx, userId, toUserId)
+		return err
+	})
+	if err != nil {
+		return 0, err
 	}
-	return userIDs, nil
+	return followId, nil
 }
 
-// AddFollow 数据库添加关注关系
-func (r *relationRepo) AddFollow(ctx context.Context, userId uint32, toUserId uint32) error {
-	err := r.data.db.WithContext(ctx).
-		Where("user_id = ? AND follower_id = ?", toUserId, userId).
-		First(&Followers{}).Error
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		follow := &Followers{
-			UserId:     toUserId,
-			FollowerId: userId,
-		}
-		result := r.data.db.WithContext(ctx).Create(&follow)
-		if result.Error != nil {
-			return errors.Join(ErrMysqlInsert, result.Error)
-		}
-		go func() {
-			err := kafkaX.Update(r.kfk.follow, strconv.Itoa(int(userId)), "1")
-			if err != nil {
-				r.log.Error(err)
-			}
-		}()
-		go func() {
-			err := kafkaX.Update(r.kfk.follower, strconv.Itoa(int(toUserId)), "1")
-			if err != nil {
-				r.log.Error(err)
-			}
-		}()
-		return nil
+// addFollowTx 在事务中插入关注关系并写入计数变更事件，返回新关系的id，未插入新关系时为0。
+// 关系的自增id与关注先后一致，粉丝缓存以它作为排序分数
+func (r *relationRepo) addFollowTx(tx *gorm.DB, userId uint32, toUserId uint32) (uint32, error) {
+	follow := &Followers{
+		UserId:     toUserId,
+		FollowerId: userId,
 	}
-	if err != nil {
-		return errors.Join(ErrMysqlQuery, err)
+	result := tx.Model(&Followers{}).Clauses(clause.OnConflict{DoNothing: true}).Create(follow)
+	if result.Error != nil {
+		return 0, errors.Join(ErrMysqlInsert, result.Error)
+	}
+	// 重复关注不产生计数变化
+	if result.RowsAffected == 0 {
+		return 0, nil
 	}
-	return ErrExistRelation
+	return follow.Id, r.addCountEvents(tx, userId, toUserId, "1")
 }
 
-// DelFollow 数据库取消关注关系
-func (r *relationRepo) DelFollow(ctx context.Context, userId uint32, toUserId uint32) error {
-	err := r.data.db.WithContext(ctx).
-		Where("user_id = ? AND follower_id = ?", toUserId, userId).
-		First(&Followers{}).Error
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return ErrNotExistRelation
-	}
+// DelFollow 数据库取消关注关系，返回是否真正删除了关系
+func (r *relationRepo) DelFollow(ctx context.Context, userId uint32, toUserId uint32) (bool, error) {
+	changed := false
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) (err error) {
+		changed, err = r.delFollowTx(tx, userId, toUserId)
+		return err
+	})
 	if err != nil {
-		return errors.Join(ErrMysqlQuery, err)
+		return false, err
 	}
-	result := r.data.db.WithContext(ctx).Where(
+	return changed, nil
+}
+
+// delFollowTx 在事务中删除关注关系并写入计数变更事件，返回是否真正删除了关系
+func (r *relationRepo) delFollowTx(tx *gorm.DB, userId uint32, toUserId uint32) (bool, error) {
+	result := tx.Model(&Followers{}).Where(
 		"user_id = ? AND follower_id = ?", toUserId, userId).Delete(&Followers{})
 	if result.Error != nil {
-		return errors.Join(ErrMysqlDelete, result.Error)
+		return false, errors.Join(ErrMysqlDelete, result.Error)
 	}
-	go func() {
-		err := kafkaX.Update(r.kfk.follow, strconv.Itoa(int(userId)), "-1")
-		if err != nil {
-			r.log.Error(err)
-		}
-	}()
-	go func() {
-		err := kafkaX.Update(r.kfk.follower, strconv.Itoa(int(toUserId)), "-1")
-		if err != nil {
-			r.log.Error(err)
-		}
-	}()
-	return nil
+	// 重复取消关注不产生计数变化
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+	return true, r.addCountEvents(tx, userId, toUserId, "-1")
+}
+
+// addCountEvents 在事务中写入关注数和粉丝数的变更事件
+func (r *relationRepo) addCountEvents(tx *gorm.DB, userId, toUserId uint32, value string) error {
+	if err := outboxX.Add(tx, r.kfk.follow.Topic, strconv.Itoa(int(userId)), value); err != nil {
+		return err
+	}
+	return outboxX.Add(tx, r.kfk.follower.Topic, strconv.Itoa(int(toUserId)), value)
 }
 
 // SearchRelation 数据库查询关注关系
@@ -544,8 +502,13 @@ func (r *relationRepo) SearchRelation(ctx context.Context, userId uint32, toUser
 	return slice, nil
 }
 
-// CreateCacheByTran 缓存创建事务
+// CreateCacheByTran 关注列表缓存创建事务
 func CreateCacheByTran(ctx context.Context, cache *redis.Client, ul []uint32, userId uint32) error {
+	return createCacheByKey(ctx, cache, ul, followKey(userId))
+}
+
+// createCacheByKey 使用事务将用户id列表存入指定key的缓存
+func createCacheByKey(ctx context.Context, cache *redis.Client, ul []uint32, key string) error {
 	// 使用事务将列表存入redis缓存
 	_, err := cache.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
 		insertMap := make(map[string]interface{}, len(ul))
@@ -554,13 +517,13 @@ func CreateCacheByTran(ctx context.Context, cache *redis.Client, ul []uint32, us
 			vs := strconv.Itoa(int(v))
 			insertMap[vs] = OccupyValue
 		}
-		err := pipe.HMSet(ctx, strconv.Itoa(int(userId)), insertMap).Err()
+		err := pipe.HMSet(ctx, key, insertMap).Err()
 		if err != nil {
 			return errors.Join(ErrRedisSet, err)
 		}
 		// 将评论数量存入redis缓存,使用随机过期时间防止缓存雪崩
 		begin, end := 360, 720
-		err = pipe.Expire(ctx, strconv.Itoa(int(userId)), randomTime(time.Minute, begin, end)).Err()
+		err = pipe.Expire(ctx, key, randomTime(time.Minute, begin, end)).Err()
 		if err != nil {
 			return errors.Join(ErrRedisSet, err)
 		}
@@ -0,0 +1,116 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/toomanysource/atreus/app/relation/service/internal/biz"
+	"github.com/toomanysource/atreus/middleware"
+)
+
+// FollowRequest 待私密账号UserId处理的关注请求，同意后才建立关注关系
+type FollowRequest struct {
+	Id          uint32 `gorm:"primary_key"`
+	UserId      uint32 `gorm:"column:user_id;not null;uniqueIndex:uk_user_requester"`
+	RequesterId uint32 `gorm:"column:requester_id;not null;uniqueIndex:uk_user_requester;index:idx_requester_id"`
+}
+
+func (FollowRequest) TableName() string {
+	return "follow_requests"
+}
+
+// IsPrivate 判断用户是否为私密账号
+func (r *relationRepo) IsPrivate(ctx context.Context, userId uint32) (bool, error) {
+	return r.userRepo.IsPrivate(ctx, userId)
+}
+
+// AddFollowRequest 向私密账号发送关注请求，返回是否产生了新的请求
+func (r *relationRepo) AddFollowRequest(ctx context.Context, toUserId uint32) (bool, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	if err := r.checkFollow(ctx, userId, toUserId); err != nil {
+		return false, err
+	}
+	result := r.data.db.WithContext(ctx).Model(&FollowRequest{}).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&FollowRequest{UserId: toUserId, RequesterId: userId})
+	if result.Error != nil {
+		return false, errors.Join(ErrMysqlInsert, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+	r.log.Infof("AddFollowRequest -> userId: %v - toUserId: %v", userId, toUserId)
+	return true, nil
+}
+
+// DelFollowRequest 撤回发送给toUserId的关注请求，返回是否真正撤回了请求
+func (r *relationRepo) DelFollowRequest(ctx context.Context, toUserId uint32) (bool, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	return r.delFollowRequest(ctx, toUserId, userId)
+}
+
+// AcceptFollowRequest 同意fromUserId的关注请求，删除请求和建立关注关系在同一事务中完成，
+// 关注数和粉丝数的变更事件只在此时产生
+func (r *relationRepo) AcceptFollowRequest(ctx context.Context, fromUserId uint32) (bool, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	var followId uint32
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&FollowRequest{}).
+			Where("user_id = ? AND requester_id = ?", userId, fromUserId).
+			Delete(&FollowRequest{})
+		if result.Error != nil {
+			return errors.Join(ErrMysqlDelete, result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		var err error
+		followId, err = r.addFollowTx(tx, fromUserId, userId)
+		return err
+	})
+	if err != nil || followId == 0 {
+		return false, err
+	}
+	r.addFollowCaches(fromUserId, userId, followId)
+	r.log.Infof("AcceptFollowRequest -> userId: %v - fromUserId: %v", userId, fromUserId)
+	return true, nil
+}
+
+// RejectFollowRequest 拒绝fromUserId的关注请求，返回是否真正删除了请求
+func (r *relationRepo) RejectFollowRequest(ctx context.Context, fromUserId uint32) (bool, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	return r.delFollowRequest(ctx, userId, fromUserId)
+}
+
+// GetFollowRequestList 获取用户待处理的关注请求，按请求时间排序
+func (r *relationRepo) GetFollowRequestList(ctx context.Context, userId uint32) ([]*biz.User, error) {
+	var ids []uint32
+	err := r.data.db.WithContext(ctx).Model(&FollowRequest{}).
+		Where("user_id = ?", userId).Order("id").Pluck("requester_id", &ids).Error
+	if err != nil {
+		return nil, errors.Join(ErrMysqlQuery, err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return r.userRepo.GetUserInfos(ctx, userId, ids)
+}
+
+// delFollowRequest 删除requesterId发送给userId的关注请求
+func (r *relationRepo) delFollowRequest(ctx context.Context, userId, requesterId uint32) (bool, error) {
+	return delFollowRequestTx(r.data.db.WithContext(ctx), userId, requesterId)
+}
+
+// delFollowRequestTx 在事务中删除requesterId发给userId的关注请求，返回是否真正删除了请求
+func delFollowRequestTx(tx *gorm.DB, userId, requesterId uint32) (bool, error) {
+	result := tx.Model(&FollowRequest{}).
+		Where("user_id = ? AND requester_id = ?", userId, requesterId).
+		Delete(&FollowRequest{})
+	if result.Error != nil {
+		return false, errors.Join(ErrMysqlDelete, result.Error)
+	}
+	return result.RowsAffected != 0, nil
+}
@@ -0,0 +1,183 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/toomanysource/atreus/app/relation/service/internal/biz"
+)
+
+// suggestKeyPrefix 推荐列表缓存的key前缀
+const suggestKeyPrefix = "suggest:"
+
+const (
+	SuggestCandidateLimit = 500 // 每种关系最多统计的候选用户数量
+	SuggestCacheCount     = 100 // 每个用户缓存的推荐用户数量
+)
+
+// 推荐候选用户的统计语句，参数依次为用户id、用户id、用户id、数量上限，
+// 排除用户自己和已经关注的用户
+var (
+	mutualFollowQuery = "SELECT f2.user_id AS id, COUNT(*) AS count FROM followers f1 " +
+		"JOIN followers f2 ON f2.follower_id = f1.user_id " +
+		"WHERE f1.follower_id = ? AND f2.user_id <> ? " +
+		"AND f2.user_id NOT IN (SELECT user_id FROM followers WHERE follower_id = ?) " +
+		"GROUP BY f2.user_id ORDER BY count DESC LIMIT ?"
+	sharedFollowerQuery = "SELECT f2.user_id AS id, COUNT(*) AS count FROM followers f1 " +
+		"JOIN followers f2 ON f2.follower_id = f1.follower_id " +
+		"WHERE f1.user_id = ? AND f2.user_id <> ? " +
+		"AND f2.user_id NOT IN (SELECT user_id FROM followers WHERE follower_id = ?) " +
+		"GROUP BY f2.user_id ORDER BY count DESC LIMIT ?"
+)
+
+// idCount 按id分组统计的结果
+type idCount struct {
+	Id    uint32
+	Count uint32
+}
+
+// SuggestUsers 获取用户可能认识的人，排除已经关注的用户和任意一方屏蔽了对方的用户
+func (r *relationRepo) SuggestUsers(ctx context.Context, userId uint32, count int) ([]*biz.User, error) {
+	ids, err := r.getSuggestIds(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	// 缓存期间新产生的关注和屏蔽关系在读取时排除
+	follows, err := r.IsFollow(ctx, userId, ids)
+	if err != nil {
+		return nil, err
+	}
+	exclude := make(map[uint32]bool)
+	for _, prefix := range []string{blockKeyPrefix, blockedByKeyPrefix} {
+		blockIds, err := r.getBlockIds(ctx, prefix, userId)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range blockIds {
+			exclude[id] = true
+		}
+	}
+	suggestIds := make([]uint32, 0, count)
+	for i, id := range ids {
+		if follows[i] || exclude[id] {
+			continue
+		}
+		suggestIds = append(suggestIds, id)
+		if len(suggestIds) == count {
+			break
+		}
+	}
+	if len(suggestIds) == 0 {
+		return nil, nil
+	}
+	users, err := r.userRepo.GetUserInfos(ctx, userId, suggestIds)
+	if err != nil {
+		return nil, err
+	}
+	// 按推荐顺序返回
+	userMap := make(map[uint32]*biz.User, len(users))
+	for _, user := range users {
+		userMap[user.Id] = user
+	}
+	sorted := make([]*biz.User, 0, len(users))
+	for _, id := range suggestIds {
+		if user, ok := userMap[id]; ok {
+			sorted = append(sorted, user)
+		}
+	}
+	return sorted, nil
+}
+
+// getSuggestIds 获取按推荐程度排序的用户id，缓存不存在时从数据库统计并创建缓存
+func (r *relationRepo) getSuggestIds(ctx context.Context, userId uint32) ([]uint32, error) {
+	key := suggestKeyPrefix + strconv.Itoa(int(userId))
+	values, err := r.data.cache.suggest.ZRevRange(ctx, key, 0, -1).Result()
+	if err != nil {
+		return nil, errors.Join(ErrRedisQuery, err)
+	}
+	if len(values) > 0 {
+		ids := make([]uint32, 0, len(values))
+		for _, v := range values {
+			if v == OccupyKey {
+				continue
+			}
+			id, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, uint32(id))
+		}
+		return ids, nil
+	}
+	candidates, err := r.GetSuggestCandidatesFromDB(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	ranked := biz.RankSuggestions(candidates, SuggestCacheCount)
+	// 将推荐列表存入redis缓存，空列表也会缓存占位成员
+	go func() {
+		if err := r.CreateSuggestCache(context.Background(), key, ranked); err != nil {
+			r.log.Error(err)
+			return
+		}
+		r.log.Info("redis transaction success")
+	}()
+	ids := make([]uint32, 0, len(ranked))
+	for _, c := range ranked {
+		ids = append(ids, c.Id)
+	}
+	return ids, nil
+}
+
+// GetSuggestCandidatesFromDB 数据库统计二度关注和共同粉丝的候选用户
+func (r *relationRepo) GetSuggestCandidatesFromDB(ctx context.Context, userId uint32) ([]*biz.SuggestCandidate, error) {
+	var mutual, shared []*idCount
+	db := r.data.db.WithContext(ctx)
+	err := db.Raw(mutualFollowQuery, userId, userId, userId, SuggestCandidateLimit).Scan(&mutual).Error
+	if err != nil {
+		return nil, errors.Join(ErrMysqlQuery, err)
+	}
+	err = db.Raw(sharedFollowerQuery, userId, userId, userId, SuggestCandidateLimit).Scan(&shared).Error
+	if err != nil {
+		return nil, errors.Join(ErrMysqlQuery, err)
+	}
+	candidates := make([]*biz.SuggestCandidate, 0, len(mutual)+len(shared))
+	for _, v := range mutual {
+		candidates = append(candidates, &biz.SuggestCandidate{Id: v.Id, MutualFollows: v.Count})
+	}
+	for _, v := range shared {
+		candidates = append(candidates, &biz.SuggestCandidate{Id: v.Id, SharedFollowers: v.Count})
+	}
+	return candidates, nil
+}
+
+// CreateSuggestCache 使用有序集合缓存推荐列表，分数为组合分数，ZRevRange读取的顺序与RankSuggestions一致
+func (r *relationRepo) CreateSuggestCache(ctx context.Context, key string, ranked []*biz.SuggestCandidate) error {
+	_, err := r.data.cache.suggest.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		members := make([]*redis.Z, 0, len(ranked)+1)
+		members = append(members, &redis.Z{Score: -1, Member: OccupyKey})
+		for _, c := range ranked {
+			members = append(members, &redis.Z{Score: c.RankScore(), Member: strconv.Itoa(int(c.Id))})
+		}
+		if err := pipe.ZAdd(ctx, key, members...).Err(); err != nil {
+			return errors.Join(ErrRedisSet, err)
+		}
+		// 推荐列表随关注关系变化，使用较短的随机过期时间
+		begin, end := 30, 60
+		if err := pipe.Expire(ctx, key, randomTime(time.Minute, begin, end)).Err(); err != nil {
+			return errors.Join(ErrRedisSet, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Join(ErrRedisTransaction, err)
+	}
+	return nil
+}
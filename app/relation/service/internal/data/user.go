@@ -33,3 +33,12 @@ func (u *userRepo) GetUserInfos(ctx context.Context, userId uint32, userIds []ui
 	}
 	return users, nil
 }
+
+// IsPrivate 通过User服务判断用户是否为私密账号
+func (u *userRepo) IsPrivate(ctx context.Context, userId uint32) (bool, error) {
+	resp, err := u.client.GetUserSettings(ctx, &pb.UserSettingsRequest{UserId: userId})
+	if err != nil {
+		return false, errors.Join(ErrUserServiceResponse, err)
+	}
+	return resp.GetSettings().GetIsPrivate(), nil
+}
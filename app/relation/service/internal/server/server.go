@@ -15,11 +15,12 @@ import (
 	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
 	"github.com/hashicorp/consul/api"
 
+	messagev1 "github.com/toomanysource/atreus/api/message/service/v1"
 	userv1 "github.com/toomanysource/atreus/api/user/service/v1"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewUserClient, NewDiscovery, NewRegistrar)
+var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewUserClient, NewMessageClient, NewDiscovery, NewRegistrar)
 
 // NewUserClient 创建一个User服务客户端，接收User服务数据
 func NewUserClient(r registry.Discovery, logger log.Logger) userv1.UserServiceClient {
@@ -40,6 +41,25 @@ func NewUserClient(r registry.Discovery, logger log.Logger) userv1.UserServiceCl
 	return userv1.NewUserServiceClient(conn)
 }
 
+// NewMessageClient 创建一个Message服务客户端，接收Message服务数据
+func NewMessageClient(r registry.Discovery, logger log.Logger) messagev1.MessageServiceClient {
+	logs := log.NewHelper(log.With(logger, "module", "server/message"))
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint("discovery:///atreus.message.service"),
+		grpc.WithDiscovery(r),
+		grpc.WithMiddleware(
+			recovery.Recovery(),
+			logging.Server(logger),
+		),
+	)
+	if err != nil {
+		logs.Fatalf("message service connect error, %v", err)
+	}
+	logs.Info("message service connect successfully")
+	return messagev1.NewMessageServiceClient(conn)
+}
+
 func NewDiscovery(conf *conf.Registry) registry.Discovery {
 	c := api.DefaultConfig()
 	c.Address = conf.Consul.Address
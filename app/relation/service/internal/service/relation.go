@@ -27,12 +27,13 @@ func NewRelationService(uc *biz.RelationUseCase, logger log.Logger) *RelationSer
 // RelationAction 关注/取消关注
 func (s *RelationService) RelationAction(ctx context.Context, req *pb.RelationActionRequest) (*pb.RelationActionReply, error) {
 	reply := &pb.RelationActionReply{StatusCode: CodeSuccess, StatusMsg: "success"}
-	err := s.ru.Action(ctx, req.ToUserId, req.ActionType)
+	pending, err := s.ru.Action(ctx, req.ToUserId, req.ActionType)
 	if err != nil {
 		reply.StatusCode = CodeFailed
 		reply.StatusMsg = err.Error()
 		return reply, nil
 	}
+	reply.IsPending = pending
 	return reply, nil
 }
 
@@ -57,7 +58,7 @@ func (s *RelationService) GetFollowRelationList(ctx context.Context, req *pb.Rel
 // GetFollowerRelationList 获取粉丝列表
 func (s *RelationService) GetFollowerRelationList(ctx context.Context, req *pb.RelationFollowerListRequest) (*pb.RelationFollowerListReply, error) {
 	reply := &pb.RelationFollowerListReply{StatusCode: CodeSuccess, StatusMsg: "success", UserList: make([]*pb.User, 0)}
-	list, err := s.ru.GetFollowerList(ctx, req.UserId)
+	list, next, err := s.ru.GetFollowerList(ctx, req.UserId, req.Cursor, req.Count)
 	if err != nil {
 		reply.StatusCode = CodeFailed
 		reply.StatusMsg = err.Error()
@@ -69,13 +70,14 @@ func (s *RelationService) GetFollowerRelationList(ctx context.Context, req *pb.R
 		reply.StatusMsg = err.Error()
 		return reply, nil
 	}
+	reply.NextCursor = next
 	return reply, nil
 }
 
-// GetFriendRelationList 获取粉丝列表
+// GetFriendRelationList 获取好友列表及与好友的最新聊天消息
 func (s *RelationService) GetFriendRelationList(ctx context.Context, req *pb.RelationFriendListRequest) (*pb.RelationFriendListReply, error) {
 	reply := &pb.RelationFriendListReply{StatusCode: CodeSuccess, StatusMsg: "success", UserList: make([]*pb.FriendUser, 0)}
-	list, err := s.ru.GetFollowerList(ctx, req.UserId)
+	list, err := s.ru.GetFriendList(ctx, req.UserId)
 	if err != nil {
 		reply.StatusCode = CodeFailed
 		reply.StatusMsg = err.Error()
@@ -99,3 +101,138 @@ func (s *RelationService) IsFollow(ctx context.Context, req *pb.IsFollowRequest)
 		IsFollow: isFollow,
 	}, nil
 }
+
+// BlockAction 屏蔽/取消屏蔽
+func (s *RelationService) BlockAction(ctx context.Context, req *pb.BlockActionRequest) (*pb.BlockActionReply, error) {
+	reply := &pb.BlockActionReply{StatusCode: CodeSuccess, StatusMsg: "success"}
+	err := s.ru.BlockAction(ctx, req.ToUserId, req.ActionType)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	return reply, nil
+}
+
+// MuteAction 静音/取消静音
+func (s *RelationService) MuteAction(ctx context.Context, req *pb.MuteActionRequest) (*pb.MuteActionReply, error) {
+	reply := &pb.MuteActionReply{StatusCode: CodeSuccess, StatusMsg: "success"}
+	err := s.ru.MuteAction(ctx, req.ToUserId, req.ActionType)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	return reply, nil
+}
+
+// GetBlockList 获取屏蔽列表
+func (s *RelationService) GetBlockList(ctx context.Context, req *pb.BlockListRequest) (*pb.BlockListReply, error) {
+	reply := &pb.BlockListReply{StatusCode: CodeSuccess, StatusMsg: "success", UserList: make([]*pb.User, 0)}
+	list, err := s.ru.GetBlockList(ctx)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	err = copier.Copy(&reply.UserList, &list)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	return reply, nil
+}
+
+// FollowRequestAction 同意/拒绝关注请求
+func (s *RelationService) FollowRequestAction(
+	ctx context.Context, req *pb.FollowRequestActionRequest,
+) (*pb.FollowRequestActionReply, error) {
+	reply := &pb.FollowRequestActionReply{StatusCode: CodeSuccess, StatusMsg: "success"}
+	err := s.ru.FollowRequestAction(ctx, req.FromUserId, req.ActionType)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	return reply, nil
+}
+
+// GetFollowRequestList 获取待处理的关注请求列表
+func (s *RelationService) GetFollowRequestList(
+	ctx context.Context, req *pb.FollowRequestListRequest,
+) (*pb.FollowRequestListReply, error) {
+	reply := &pb.FollowRequestListReply{StatusCode: CodeSuccess, StatusMsg: "success", UserList: make([]*pb.User, 0)}
+	list, err := s.ru.GetFollowRequestList(ctx)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	err = copier.Copy(&reply.UserList, &list)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	return reply, nil
+}
+
+// SuggestUsers 获取可能认识的人
+func (s *RelationService) SuggestUsers(ctx context.Context, req *pb.SuggestUsersRequest) (*pb.SuggestUsersReply, error) {
+	reply := &pb.SuggestUsersReply{StatusCode: CodeSuccess, StatusMsg: "success", UserList: make([]*pb.User, 0)}
+	list, err := s.ru.SuggestUsers(ctx, req.Count)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	err = copier.Copy(&reply.UserList, &list)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	return reply, nil
+}
+
+// GetCommonFollowList 获取自己关注的人中同样关注了对方的用户
+func (s *RelationService) GetCommonFollowList(
+	ctx context.Context, req *pb.CommonFollowListRequest,
+) (*pb.CommonFollowListReply, error) {
+	reply := &pb.CommonFollowListReply{StatusCode: CodeSuccess, StatusMsg: "success", UserList: make([]*pb.User, 0)}
+	total, list, err := s.ru.GetCommonFollowList(ctx, req.ToUserId, req.Count)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	err = copier.Copy(&reply.UserList, &list)
+	if err != nil {
+		reply.StatusCode = CodeFailed
+		reply.StatusMsg = err.Error()
+		return reply, nil
+	}
+	reply.CommonCount = total
+	return reply, nil
+}
+
+func (s *RelationService) IsBlocked(ctx context.Context, req *pb.IsBlockedRequest) (*pb.IsBlockedReply, error) {
+	isBlocked, err := s.ru.IsBlocked(ctx, req.UserId, req.ToUserId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.IsBlockedReply{
+		IsBlocked: isBlocked,
+	}, nil
+}
+
+func (s *RelationService) GetMuteList(ctx context.Context, req *pb.MuteListRequest) (*pb.MuteListReply, error) {
+	ids, err := s.ru.GetMuteList(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MuteListReply{
+		UserIds: ids,
+	}, nil
+}
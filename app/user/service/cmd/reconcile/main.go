@@ -0,0 +1,55 @@
+// reconcile 根据followers、favorites和videos表重新计算users表中的关注数、粉丝数、
+// 点赞数、获赞数和作品数，修复Kafka消息丢失或重复导致的计数偏差。
+//
+// 默认执行一次后退出；指定-interval后按间隔定期执行，可作为常驻的校准任务运行。
+//
+//	go run ./app/user/service/cmd/reconcile -conf app/user/service/configs -interval 1h
+package main
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"github.com/toomanysource/atreus/app/user/service/internal/conf"
+	"github.com/toomanysource/atreus/app/user/service/internal/data"
+	"github.com/toomanysource/atreus/pkg/cmdX"
+)
+
+var (
+	flagConf     string
+	flagInterval time.Duration
+	flagBatch    int
+)
+
+func init() {
+	flag.StringVar(&flagConf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.DurationVar(&flagInterval, "interval", 0, "reconcile interval, run once if zero, eg: -interval 1h")
+	flag.IntVar(&flagBatch, "batch", data.ReconcileBatchSize, "users per batch, eg: -batch 500")
+}
+
+func main() {
+	flag.Parse()
+	l, logs := cmdX.NewLogger("cmd/reconcile")
+	var bc conf.Bootstrap
+	if err := cmdX.LoadConfig(flagConf, &bc); err != nil {
+		panic(err)
+	}
+	db, err := cmdX.OpenDB(bc.Data.Database.Source)
+	if err != nil {
+		logs.Fatalf("database connection failure, err : %v", err)
+	}
+	rdb := data.NewRedisConn(bc.Data, l)
+	defer rdb.Close()
+
+	cmdX.Run(flagInterval, func(ctx context.Context) {
+		start := time.Now()
+		result, err := data.ReconcileUserCounters(ctx, db, rdb, flagBatch)
+		if err != nil {
+			logs.Errorf("reconcile user counters failed, err : %v", err)
+			return
+		}
+		logs.Infof("reconcile user counters finished, scanned: %d, changed: %d, cost: %v",
+			result.Scanned, result.Changed, time.Since(start))
+	})
+}
@@ -35,7 +35,9 @@ func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Da
 	relationServiceClient := server.NewRelationClient(discovery, logger)
 	relationRepo := data.NewRelationRepo(relationServiceClient)
 	userUsecase := biz.NewUserUsecase(userRepo, relationRepo, jwt, logger)
-	userService := service.NewUserService(userUsecase, logger)
+	keyRepo := data.NewKeyRepo(dataData, logger)
+	keyUsecase := biz.NewKeyUsecase(keyRepo, logger)
+	userService := service.NewUserService(userUsecase, keyUsecase, logger)
 	grpcServer := server.NewGRPCServer(confServer, userService, logger)
 	httpServer := server.NewHTTPServer(confServer, jwt, userService, logger)
 	registrar := server.NewRegistrar(registry)
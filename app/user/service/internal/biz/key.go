@@ -0,0 +1,89 @@
+package biz
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrInvalidPublicKey  = errors.New("无效的公钥")
+	ErrPublicKeyNotFound = errors.New("用户未注册公钥")
+)
+
+// 公钥解码后的长度范围
+const (
+	MinPublicKeySize = 32
+	MaxPublicKeySize = 1024
+)
+
+// PublicKey 是用户用于端到端加密私信的公钥，轮换后旧公钥保留，用于解密使用旧公钥加密的消息
+type PublicKey struct {
+	Id         uint32
+	UserId     uint32
+	PublicKey  string
+	IsCurrent  bool
+	CreateTime int64
+}
+
+// KeyRepo 定义公钥存储的方法集合
+type KeyRepo interface {
+	CreateKey(ctx context.Context, userId uint32, publicKey string) (*PublicKey, error)
+	FindCurrentKey(ctx context.Context, userId uint32) (*PublicKey, error)
+	FindKeysByIds(ctx context.Context, ids []uint32) ([]*PublicKey, error)
+}
+
+// KeyUsecase 是公钥的用例
+type KeyUsecase struct {
+	repo KeyRepo
+	log  *log.Helper
+}
+
+func NewKeyUsecase(repo KeyRepo, logger log.Logger) *KeyUsecase {
+	return &KeyUsecase{
+		repo: repo,
+		log:  log.NewHelper(log.With(logger, "biz", "key_usecase")),
+	}
+}
+
+// RegisterKey 注册公钥，新公钥成为当前公钥，原当前公钥被轮换
+func (uc *KeyUsecase) RegisterKey(ctx context.Context, userId uint32, publicKey string) (*PublicKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil || len(raw) < MinPublicKeySize || len(raw) > MaxPublicKeySize {
+		return nil, ErrInvalidPublicKey
+	}
+	key, err := uc.repo.CreateKey(ctx, userId, publicKey)
+	if err != nil {
+		uc.log.Errorf("注册公钥失败，原因: %s", err.Error())
+		return nil, ErrInternal
+	}
+	return key, nil
+}
+
+// GetCurrentKey 获取用户的当前公钥
+func (uc *KeyUsecase) GetCurrentKey(ctx context.Context, userId uint32) (*PublicKey, error) {
+	key, err := uc.repo.FindCurrentKey(ctx, userId)
+	if errors.Is(err, ErrPublicKeyNotFound) {
+		return nil, ErrPublicKeyNotFound
+	}
+	if err != nil {
+		uc.log.Errorf("获取公钥失败，原因: %s", err.Error())
+		return nil, ErrInternal
+	}
+	return key, nil
+}
+
+// GetKeys 根据公钥id获取公钥，包括已轮换的旧公钥
+func (uc *KeyUsecase) GetKeys(ctx context.Context, ids []uint32) ([]*PublicKey, error) {
+	if len(ids) == 0 {
+		return []*PublicKey{}, nil
+	}
+	keys, err := uc.repo.FindKeysByIds(ctx, ids)
+	if err != nil {
+		uc.log.Errorf("获取公钥失败，原因: %s", err.Error())
+		return nil, ErrInternal
+	}
+	return keys, nil
+}
@@ -12,8 +12,16 @@ import (
 )
 
 var (
-	ErrUserNotFound = errors.New("无法找到此用户")
-	ErrInternal     = errors.New("服务内部错误")
+	ErrUserNotFound    = errors.New("无法找到此用户")
+	ErrInternal        = errors.New("服务内部错误")
+	ErrInvalidSettings = errors.New("无效的隐私设置")
+)
+
+// 喜爱列表可见范围
+const (
+	FavoritePublic    uint32 = 0
+	FavoriteFollowers uint32 = 1
+	FavoritePrivate   uint32 = 2
 )
 
 // User is a user model.
@@ -34,12 +42,26 @@ type User struct {
 	Token           string
 }
 
+// Settings 是用户的隐私设置
+type Settings struct {
+	FavoriteVisibility uint32
+	IsPrivate          bool // 私密账号的关注需要经过本人同意
+}
+
+// SettingsUpdate 是隐私设置的修改内容，为nil的字段保持不变
+type SettingsUpdate struct {
+	FavoriteVisibility *uint32
+	IsPrivate          *bool
+}
+
 // UserRepo 定义user存储的方法集合
 type UserRepo interface {
 	Create(context.Context, *User) (*User, error)
 	FindById(context.Context, uint32) (*User, error)
 	FindByIds(context.Context, []uint32) ([]*User, error)
 	FindKeyInfoByUsername(context.Context, string) (*User, error)
+	FindSettingsById(context.Context, uint32) (*Settings, error)
+	UpdateSettings(context.Context, uint32, *SettingsUpdate) error
 	RunUpdateFollowListener()
 	RunUpdateFollowerListener()
 	RunUpdateFavoriteListener()
@@ -165,6 +187,38 @@ func (uc *UserUsecase) GetInfos(ctx context.Context, userId uint32, userIds []ui
 	return users, nil
 }
 
+// GetSettings 获取用户的隐私设置
+func (uc *UserUsecase) GetSettings(ctx context.Context, userId uint32) (*Settings, error) {
+	settings, err := uc.userRepo.FindSettingsById(ctx, userId)
+	if errors.Is(err, ErrUserNotFound) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		uc.log.Errorf("获取用户隐私设置失败，原因: %s", err.Error())
+		return nil, ErrInternal
+	}
+	return settings, nil
+}
+
+// UpdateSettings 修改用户的隐私设置，只修改settings中指定的字段
+func (uc *UserUsecase) UpdateSettings(ctx context.Context, userId uint32, settings *SettingsUpdate) error {
+	if settings.FavoriteVisibility != nil && *settings.FavoriteVisibility > FavoritePrivate {
+		return ErrInvalidSettings
+	}
+	if settings.FavoriteVisibility == nil && settings.IsPrivate == nil {
+		return nil
+	}
+	err := uc.userRepo.UpdateSettings(ctx, userId, settings)
+	if errors.Is(err, ErrUserNotFound) {
+		return ErrUserNotFound
+	}
+	if err != nil {
+		uc.log.Errorf("修改用户隐私设置失败，原因: %s", err.Error())
+		return ErrInternal
+	}
+	return nil
+}
+
 // updateWorker 执行用户信息更新的监听器
 func (uc *UserUsecase) updateWorker() {
 	go uc.userRepo.RunUpdateFollowListener()
@@ -0,0 +1,112 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/jinzhu/copier"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/toomanysource/atreus/app/user/service/internal/biz"
+)
+
+var keyTableName = "user_public_keys"
+
+// PublicKey 是用户用于端到端加密私信的公钥，每个用户最多只有一个当前公钥
+type PublicKey struct {
+	Id         uint32 `gorm:"primary_key"`
+	UserId     uint32 `gorm:"column:user_id;not null;index:idx_user_current,priority:1"`
+	PublicKey  string `gorm:"column:public_key;type:text;not null"`
+	IsCurrent  bool   `gorm:"column:is_current;not null;default:false;index:idx_user_current,priority:2"`
+	CreateTime int64  `gorm:"column:create_time;not null;default:0"`
+}
+
+func (PublicKey) TableName() string {
+	return keyTableName
+}
+
+type keyRepo struct {
+	db  *gorm.DB
+	log *log.Helper
+}
+
+var _ biz.KeyRepo = (*keyRepo)(nil)
+
+// NewKeyRepo .
+func NewKeyRepo(data *Data, logger log.Logger) biz.KeyRepo {
+	logs := log.NewHelper(log.With(logger, "data", "key_repo"))
+	r := &keyRepo{
+		db:  data.db,
+		log: logs,
+	}
+	if err := r.db.AutoMigrate(&PublicKey{}); err != nil {
+		log.Fatalf("database %s initialize failed: %s", keyTableName, err.Error())
+	}
+	return r
+}
+
+// CreateKey 在同一事务中轮换用户的当前公钥并写入新公钥
+func (r *keyRepo) CreateKey(ctx context.Context, userId uint32, publicKey string) (*biz.PublicKey, error) {
+	key := &PublicKey{
+		UserId:     userId,
+		PublicKey:  publicKey,
+		IsCurrent:  true,
+		CreateTime: time.Now().UnixMilli(),
+	}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁定用户行使同一用户的并发注册依次轮换，用户没有当前公钥时更新不到任何行，只锁公钥行无法互斥
+		err := tx.Model(&User{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id").Where("id = ?", userId).Take(&User{}).Error
+		if err != nil {
+			return err
+		}
+		// 原当前公钥标记为已轮换
+		err = tx.Model(&PublicKey{}).
+			Where("user_id = ? AND is_current = ?", userId, true).
+			Update("is_current", false).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&PublicKey{}).Create(key).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := new(biz.PublicKey)
+	copier.Copy(result, key)
+	return result, nil
+}
+
+// FindCurrentKey 获取用户的当前公钥
+func (r *keyRepo) FindCurrentKey(ctx context.Context, userId uint32) (*biz.PublicKey, error) {
+	key := new(PublicKey)
+	err := r.db.WithContext(ctx).Model(&PublicKey{}).
+		Where("user_id = ? AND is_current = ?", userId, true).
+		Take(key).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrPublicKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	result := new(biz.PublicKey)
+	copier.Copy(result, key)
+	return result, nil
+}
+
+// FindKeysByIds 根据公钥id获取公钥
+func (r *keyRepo) FindKeysByIds(ctx context.Context, ids []uint32) ([]*biz.PublicKey, error) {
+	var keys []*PublicKey
+	err := r.db.WithContext(ctx).Model(&PublicKey{}).
+		Where("id IN ?", ids).
+		Find(&keys).Error
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*biz.PublicKey, 0, len(keys))
+	copier.Copy(&result, &keys)
+	return result, nil
+}
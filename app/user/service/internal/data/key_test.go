@@ -0,0 +1,44 @@
+package data
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/toomanysource/atreus/pkg/sqlmockX"
+)
+
+func newMockKeyRepo(t *testing.T) (*keyRepo, *sqlmockX.Mock) {
+	db, mock := sqlmockX.New(t)
+	return &keyRepo{db: db, log: log.NewHelper(log.DefaultLogger)}, mock
+}
+
+func TestKeyRepo_CreateKey(t *testing.T) {
+	ctx := context.Background()
+	repo, mock := newMockKeyRepo(t)
+	mock.ExpectQuery("FROM `users`", &sqlmockX.Rows{Columns: []string{"id"}, Values: [][]driver.Value{{int64(1)}}})
+	key, err := repo.CreateKey(ctx, 1, "key")
+	assert.Nil(t, err)
+	assert.True(t, key.IsCurrent)
+	// 锁定用户行后轮换原公钥并写入新公钥
+	users := mock.Selects("users")
+	assert.Equal(t, 1, len(users))
+	assert.True(t, users[0].Locked())
+	assert.Equal(t, users[0], mock.History()[0])
+	assert.Equal(t, []map[string]driver.Value{{"is_current": false}}, mock.Updates("user_public_keys"))
+	keys := mock.Inserts("user_public_keys")
+	assert.Equal(t, 1, len(keys))
+	assert.Equal(t, "key", keys[0]["public_key"])
+	assert.Equal(t, true, keys[0]["is_current"])
+
+	// 用户不存在时不写入公钥
+	repo, mock = newMockKeyRepo(t)
+	_, err = repo.CreateKey(ctx, 1, "key")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.Empty(t, mock.Updates("user_public_keys"))
+	assert.Empty(t, mock.Inserts("user_public_keys"))
+}
@@ -0,0 +1,143 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReconcileBatchSize 每批校准的用户数量
+const ReconcileBatchSize = 500
+
+// ReconcileResult 计数校准结果
+type ReconcileResult struct {
+	Scanned int64 // 检查的用户数量
+	Changed int64 // 计数存在偏差并被修复的用户数量
+}
+
+// userCounter 用户表中由Kafka增量维护的计数字段
+type userCounter struct {
+	Id             uint32
+	FollowCount    uint32
+	FollowerCount  uint32
+	FavoriteCount  uint32
+	TotalFavorited uint32
+	WorkCount      uint32
+}
+
+// idCount 按id分组统计的结果
+type idCount struct {
+	Id    uint32
+	Count uint32
+}
+
+// counterQueries 各计数字段在源数据表上的统计语句，参数为本批用户id
+var counterQueries = map[string]string{
+	"follow_count":   "SELECT follower_id AS id, COUNT(*) AS count FROM followers WHERE follower_id IN ? GROUP BY follower_id",
+	"follower_count": "SELECT user_id AS id, COUNT(*) AS count FROM followers WHERE user_id IN ? GROUP BY user_id",
+	"favorite_count": "SELECT user_id AS id, COUNT(*) AS count FROM favorites WHERE user_id IN ? GROUP BY user_id",
+	"total_favorited": "SELECT v.author_id AS id, COUNT(*) AS count FROM favorites f " +
+		"JOIN videos v ON f.video_id = v.id WHERE v.author_id IN ? GROUP BY v.author_id",
+	"work_count": "SELECT author_id AS id, COUNT(*) AS count FROM videos WHERE author_id IN ? GROUP BY author_id",
+}
+
+// ReconcileUserCounters 根据followers、favorites和videos表分批重新计算用户的各项计数，
+// 只更新存在偏差的用户并删除其缓存。
+// 校准期间仍在发件箱或Kafka中未消费的增量事件会在校准后再次生效，定期执行可以消除这部分偏差
+func ReconcileUserCounters(
+	ctx context.Context, db *gorm.DB, rdb *redis.Client, batchSize int,
+) (ReconcileResult, error) {
+	var result ReconcileResult
+	var lastId uint32
+	for {
+		var ids []uint32
+		err := db.WithContext(ctx).Model(&User{}).
+			Where("id > ?", lastId).Order("id").Limit(batchSize).Pluck("id", &ids).Error
+		if err != nil {
+			return result, err
+		}
+		if len(ids) == 0 {
+			return result, nil
+		}
+		changed, err := reconcileUserBatch(ctx, db, ids)
+		if err != nil {
+			return result, err
+		}
+		if len(changed) != 0 {
+			keys := make([]string, 0, len(changed))
+			for _, id := range changed {
+				keys = append(keys, genCacheKeyById(id))
+			}
+			if err = rdb.Del(ctx, keys...).Err(); err != nil {
+				return result, err
+			}
+		}
+		result.Scanned += int64(len(ids))
+		result.Changed += int64(len(changed))
+		lastId = ids[len(ids)-1]
+	}
+}
+
+// reconcileUserBatch 校准一批用户的计数，返回被修复的用户id
+func reconcileUserBatch(ctx context.Context, db *gorm.DB, ids []uint32) ([]uint32, error) {
+	var changed []uint32
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁定本批用户，避免与Kafka增量更新交错
+		var current []*userCounter
+		err := tx.Model(&User{}).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id, follow_count, follower_count, favorite_count, total_favorited, work_count").
+			Where("id IN ?", ids).Find(&current).Error
+		if err != nil {
+			return err
+		}
+		expected := make(map[string]map[uint32]uint32, len(counterQueries))
+		for column, query := range counterQueries {
+			var rows []*idCount
+			if err = tx.Raw(query, ids).Scan(&rows).Error; err != nil {
+				return err
+			}
+			counts := make(map[uint32]uint32, len(rows))
+			for _, row := range rows {
+				counts[row.Id] = row.Count
+			}
+			expected[column] = counts
+		}
+		for _, u := range current {
+			updates := diffUserCounter(u, expected)
+			if len(updates) == 0 {
+				continue
+			}
+			err = tx.Model(&User{}).Where("id = ?", u.Id).Updates(updates).Error
+			if err != nil {
+				return err
+			}
+			changed = append(changed, u.Id)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return changed, nil
+}
+
+// diffUserCounter 比较用户当前计数与源数据统计结果，返回需要修复的字段
+func diffUserCounter(u *userCounter, expected map[string]map[uint32]uint32) map[string]interface{} {
+	current := map[string]uint32{
+		"follow_count":    u.FollowCount,
+		"follower_count":  u.FollowerCount,
+		"favorite_count":  u.FavoriteCount,
+		"total_favorited": u.TotalFavorited,
+		"work_count":      u.WorkCount,
+	}
+	updates := make(map[string]interface{})
+	for column, value := range current {
+		if want := expected[column][u.Id]; want != value {
+			updates[column] = want
+		}
+	}
+	return updates
+}
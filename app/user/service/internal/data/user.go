@@ -29,19 +29,21 @@ var userTableName = "users"
 
 // User 是用户的全量信息，包含敏感字段，是数据库的模型
 type User struct {
-	Id              uint32         `gorm:"primary_key"`
-	Username        string         `gorm:"column:username;not null;index:idx_uname_pwd"`
-	Password        string         `gorm:"column:password;not null;index:idx_uname_pwd"`
-	Name            string         `gorm:"column:name;not null"`
-	FollowCount     uint32         `gorm:"column:follow_count;not null;default:0"`
-	FollowerCount   uint32         `gorm:"column:follower_count;not null;default:0"`
-	Avatar          string         `gorm:"column:avatar_url;type:longtext;not null"`
-	BackgroundImage string         `gorm:"column:background_image_url;type:longtext;not null"`
-	Signature       string         `gorm:"column:signature;not null;type:longtext"`
-	TotalFavorited  uint32         `gorm:"column:total_favorited;not null;default:0"`
-	WorkCount       uint32         `gorm:"column:work_count;not null;default:0"`
-	FavoriteCount   uint32         `grom:"column:favorite_count;not null;default:0"`
-	DeletedAt       gorm.DeletedAt `gorm:"column:deleted_at"`
+	Id                 uint32         `gorm:"primary_key"`
+	Username           string         `gorm:"column:username;not null;index:idx_uname_pwd"`
+	Password           string         `gorm:"column:password;not null;index:idx_uname_pwd"`
+	Name               string         `gorm:"column:name;not null"`
+	FollowCount        uint32         `gorm:"column:follow_count;not null;default:0"`
+	FollowerCount      uint32         `gorm:"column:follower_count;not null;default:0"`
+	Avatar             string         `gorm:"column:avatar_url;type:longtext;not null"`
+	BackgroundImage    string         `gorm:"column:background_image_url;type:longtext;not null"`
+	Signature          string         `gorm:"column:signature;not null;type:longtext"`
+	TotalFavorited     uint32         `gorm:"column:total_favorited;not null;default:0"`
+	WorkCount          uint32         `gorm:"column:work_count;not null;default:0"`
+	FavoriteCount      uint32         `grom:"column:favorite_count;not null;default:0"`
+	FavoriteVisibility uint32         `gorm:"column:favorite_visibility;not null;default:0"`
+	IsPrivate          bool           `gorm:"column:is_private;not null;default:false"`
+	DeletedAt          gorm.DeletedAt `gorm:"column:deleted_at"`
 }
 
 func (User) TableName() string {
@@ -217,98 +219,155 @@ func (r *userRepo) FindKeyInfoByUsername(ctx context.Context, username string) (
 	return user, nil
 }
 
+// FindSettingsById 根据用户id获取隐私设置
+func (r *userRepo) FindSettingsById(ctx context.Context, id uint32) (*biz.Settings, error) {
+	settings := new(biz.Settings)
+	err := r.db.WithContext(ctx).Model(&User{}).
+		Where("id = ?", id).
+		Select("favorite_visibility, is_private").
+		Take(settings).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// UpdateSettings 修改用户的隐私设置，只更新settings中不为nil的字段
+func (r *userRepo) UpdateSettings(ctx context.Context, id uint32, settings *biz.SettingsUpdate) error {
+	values := make(map[string]interface{}, 2)
+	if settings.FavoriteVisibility != nil {
+		values["favorite_visibility"] = *settings.FavoriteVisibility
+	}
+	if settings.IsPrivate != nil {
+		values["is_private"] = *settings.IsPrivate
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	result := r.db.WithContext(ctx).Model(&User{}).
+		Where("id = ?", id).
+		Updates(values)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// 设置未发生变化时也没有受影响的行，需要区分用户不存在的情况
+		_, err := r.FindSettingsById(ctx, id)
+		return err
+	}
+	return nil
+}
+
 func (r *userRepo) RunUpdateFollowListener() {
-	kafkaX.Reader(r.kfk.follow, r.log, func(ctx context.Context, reader *kafka.Reader, msg kafka.Message) {
+	kafkaX.Reader(r.kfk.follow, r.log, func(ctx context.Context, reader *kafka.Reader, msg kafka.Message) error {
 		userId, err := strconv.Atoi(string(msg.Key))
 		if err != nil {
 			r.log.Errorf("update user follow count, get id value failed, reason: %v", err)
-			return
+			return nil
 		}
 		change, err := strconv.Atoi(string(msg.Value))
 		if err != nil {
 			r.log.Errorf("update user follow count, get change value failed, reason: %v", err)
-			return
+			return nil
 		}
 		err = r.UpdateFollow(ctx, uint32(userId), int32(change))
-		if err != nil {
+		// 用户不存在时重试没有意义，跳过该消息
+		if errors.Is(err, gorm.ErrRecordNotFound) {
 			r.log.Errorf("update user follow count failed, reason: %v", err)
+			return nil
 		}
+		return err
 	})
 }
 
 func (r *userRepo) RunUpdateFollowerListener() {
-	kafkaX.Reader(r.kfk.follower, r.log, func(ctx context.Context, reader *kafka.Reader, msg kafka.Message) {
+	kafkaX.Reader(r.kfk.follower, r.log, func(ctx context.Context, reader *kafka.Reader, msg kafka.Message) error {
 		userId, err := strconv.Atoi(string(msg.Key))
 		if err != nil {
 			r.log.Errorf("update user follower count, get id value failed, reason: %v", err)
-			return
+			return nil
 		}
 		change, err := strconv.Atoi(string(msg.Value))
 		if err != nil {
 			r.log.Errorf("update user follower count, get change value failed, reason: %v", err)
-			return
+			return nil
 		}
 		err = r.UpdateFollower(ctx, uint32(userId), int32(change))
-		if err != nil {
+		// 用户不存在时重试没有意义，跳过该消息
+		if errors.Is(err, gorm.ErrRecordNotFound) {
 			r.log.Errorf("update user follower count failed, reason: %v", err)
+			return nil
 		}
+		return err
 	})
 }
 
 func (r *userRepo) RunUpdateFavoriteListener() {
-	kafkaX.Reader(r.kfk.favorite, r.log, func(ctx context.Context, reader *kafka.Reader, msg kafka.Message) {
+	kafkaX.Reader(r.kfk.favorite, r.log, func(ctx context.Context, reader *kafka.Reader, msg kafka.Message) error {
 		id, err := strconv.Atoi(string(msg.Key))
 		if err != nil {
 			r.log.Errorf("update user favorite count, get id value failed, reason: %v", err)
-			return
+			return nil
 		}
 		change, err := strconv.Atoi(string(msg.Value))
 		if err != nil {
 			r.log.Errorf("update user favorite count, get change value failed, reason: %v", err)
-			return
+			return nil
 		}
 		err = r.UpdateFavorite(ctx, uint32(id), int32(change))
-		if err != nil {
+		// 用户不存在时重试没有意义，跳过该消息
+		if errors.Is(err, gorm.ErrRecordNotFound) {
 			r.log.Errorf("update user favorite count failed, reason: %v", err)
+			return nil
 		}
+		return err
 	})
 }
 
 func (r *userRepo) RunUpdateFavoredListener() {
-	kafkaX.Reader(r.kfk.favored, r.log, func(ctx context.Context, reader *kafka.Reader, msg kafka.Message) {
+	kafkaX.Reader(r.kfk.favored, r.log, func(ctx context.Context, reader *kafka.Reader, msg kafka.Message) error {
 		id, err := strconv.Atoi(string(msg.Key))
 		if err != nil {
 			r.log.Errorf("update user favored count, get id value failed, reason: %v", err)
-			return
+			return nil
 		}
 		change, err := strconv.Atoi(string(msg.Value))
 		if err != nil {
 			r.log.Errorf("update user favored count, get change value failed, reason: %v", err)
-			return
+			return nil
 		}
 		err = r.UpdateFavorited(ctx, uint32(id), int32(change))
-		if err != nil {
+		// 用户不存在时重试没有意义，跳过该消息
+		if errors.Is(err, gorm.ErrRecordNotFound) {
 			r.log.Errorf("update user favored count failed, reason: %v", err)
+			return nil
 		}
+		return err
 	})
 }
 
 func (r *userRepo) RunUpdateWorkListener() {
-	kafkaX.Reader(r.kfk.publish, r.log, func(ctx context.Context, reader *kafka.Reader, msg kafka.Message) {
+	kafkaX.Reader(r.kfk.publish, r.log, func(ctx context.Context, reader *kafka.Reader, msg kafka.Message) error {
 		id, err := strconv.Atoi(string(msg.Key))
 		if err != nil {
 			r.log.Errorf("update user work count, get id value failed, reason: %v", err)
-			return
+			return nil
 		}
 		change, err := strconv.Atoi(string(msg.Value))
 		if err != nil {
 			r.log.Errorf("update user work count, get change value failed, reason: %v", err)
-			return
+			return nil
 		}
 		err = r.UpdateWork(ctx, uint32(id), int32(change))
-		if err != nil {
+		// 用户不存在时重试没有意义，跳过该消息
+		if errors.Is(err, gorm.ErrRecordNotFound) {
 			r.log.Errorf("update user work count failed, reason: %v", err)
+			return nil
 		}
+		return err
 	})
 }
 
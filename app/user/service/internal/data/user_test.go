@@ -0,0 +1,37 @@
+package data
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/toomanysource/atreus/app/user/service/internal/biz"
+	"github.com/toomanysource/atreus/pkg/sqlmockX"
+)
+
+func newMockUserRepo(t *testing.T) (*userRepo, *sqlmockX.Mock) {
+	db, mock := sqlmockX.New(t)
+	return &userRepo{db: db, log: log.NewHelper(log.DefaultLogger)}, mock
+}
+
+func TestUserRepo_UpdateSettings(t *testing.T) {
+	ctx := context.Background()
+	repo, mock := newMockUserRepo(t)
+
+	// 只更新请求中给出的设置
+	isPrivate := true
+	err := repo.UpdateSettings(ctx, 1, &biz.SettingsUpdate{IsPrivate: &isPrivate})
+	assert.Nil(t, err)
+	visibility := biz.FavoriteFollowers
+	err = repo.UpdateSettings(ctx, 1, &biz.SettingsUpdate{FavoriteVisibility: &visibility})
+	assert.Nil(t, err)
+	err = repo.UpdateSettings(ctx, 1, &biz.SettingsUpdate{})
+	assert.Nil(t, err)
+	assert.Equal(t, []map[string]driver.Value{
+		{"is_private": true},
+		{"favorite_visibility": int64(visibility)},
+	}, mock.Updates("users"))
+}
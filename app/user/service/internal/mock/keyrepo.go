@@ -0,0 +1,57 @@
+package mock
+
+import (
+	"context"
+	"time"
+
+	"github.com/toomanysource/atreus/app/user/service/internal/biz"
+)
+
+var keyTable []*biz.PublicKey
+
+type keyRepo struct{}
+
+func NewKeyRepo() biz.KeyRepo {
+	return &keyRepo{}
+}
+
+func (r *keyRepo) CreateKey(ctx context.Context, userId uint32, publicKey string) (*biz.PublicKey, error) {
+	for _, k := range keyTable {
+		if k.UserId == userId {
+			k.IsCurrent = false
+		}
+	}
+	key := &biz.PublicKey{
+		Id:         uint32(len(keyTable) + 1),
+		UserId:     userId,
+		PublicKey:  publicKey,
+		IsCurrent:  true,
+		CreateTime: time.Now().UnixMilli(),
+	}
+	keyTable = append(keyTable, key)
+	result := *key
+	return &result, nil
+}
+
+func (r *keyRepo) FindCurrentKey(ctx context.Context, userId uint32) (*biz.PublicKey, error) {
+	for _, k := range keyTable {
+		if k.UserId == userId && k.IsCurrent {
+			result := *k
+			return &result, nil
+		}
+	}
+	return nil, biz.ErrPublicKeyNotFound
+}
+
+func (r *keyRepo) FindKeysByIds(ctx context.Context, ids []uint32) ([]*biz.PublicKey, error) {
+	result := make([]*biz.PublicKey, 0, len(ids))
+	for _, id := range ids {
+		for _, k := range keyTable {
+			if k.Id == id {
+				key := *k
+				result = append(result, &key)
+			}
+		}
+	}
+	return result, nil
+}
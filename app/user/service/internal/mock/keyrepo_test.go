@@ -0,0 +1,55 @@
+package mock_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/toomanysource/atreus/app/user/service/internal/biz"
+	"github.com/toomanysource/atreus/app/user/service/internal/mock"
+)
+
+var keyUsecase = biz.NewKeyUsecase(mock.NewKeyRepo(), log.DefaultLogger)
+
+func TestKeyUsecase_RegisterKey(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		publicKey string
+		err       error
+	}{
+		{"not base64!", biz.ErrInvalidPublicKey},
+		{base64.StdEncoding.EncodeToString([]byte("short")), biz.ErrInvalidPublicKey},
+		{base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, biz.MaxPublicKeySize+1)), biz.ErrInvalidPublicKey},
+		{base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, biz.MinPublicKeySize)), nil},
+	}
+	for _, tt := range tests {
+		_, err := keyUsecase.RegisterKey(ctx, 100, tt.publicKey)
+		assert.ErrorIs(t, err, tt.err)
+	}
+}
+
+func TestKeyUsecase_Rotate(t *testing.T) {
+	ctx := context.Background()
+	_, err := keyUsecase.GetCurrentKey(ctx, 200)
+	assert.ErrorIs(t, err, biz.ErrPublicKeyNotFound)
+
+	first, err := keyUsecase.RegisterKey(ctx, 200, base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32)))
+	assert.NoError(t, err)
+	second, err := keyUsecase.RegisterKey(ctx, 200, base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, 32)))
+	assert.NoError(t, err)
+
+	current, err := keyUsecase.GetCurrentKey(ctx, 200)
+	assert.NoError(t, err)
+	assert.Equal(t, second.Id, current.Id)
+
+	// 轮换后旧公钥仍可以通过id获取
+	keys, err := keyUsecase.GetKeys(ctx, []uint32{first.Id, second.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(keys))
+	assert.False(t, keys[0].IsCurrent)
+	assert.True(t, keys[1].IsCurrent)
+}
@@ -11,28 +11,30 @@ import (
 )
 
 type UserDetail struct {
-	Id              uint32
-	Username        string
-	Password        string
-	Name            string
-	FollowCount     uint32
-	FollowerCount   uint32
-	Avatar          string
-	BackgroundImage string
-	Signature       string
-	TotalFavorited  uint32
-	WorkCount       uint32
-	FavoriteCount   uint32
-	DeletedAt       gorm.DeletedAt
+	Id                 uint32
+	Username           string
+	Password           string
+	Name               string
+	FollowCount        uint32
+	FollowerCount      uint32
+	Avatar             string
+	BackgroundImage    string
+	Signature          string
+	TotalFavorited     uint32
+	WorkCount          uint32
+	FavoriteCount      uint32
+	DeletedAt          gorm.DeletedAt
+	FavoriteVisibility uint32
+	IsPrivate          bool
 }
 
 var userTable = []*UserDetail{
-	{1, "xiaoming", "mingxiao", "xiaoming", 1, 1, "avatar_1", "background_image_1", "signature_1", 1, 1, 1, gorm.DeletedAt{}},
-	{2, "xiaohong", "hongxiao", "xiaohong", 2, 2, "avatar_2", "background_image_2", "signature_2", 2, 2, 2, gorm.DeletedAt{}},
-	{3, "liuzi", "ziliu", "liuzi", 3, 3, "avatar_3", "background_image_3", "signature_3", 3, 3, 3, gorm.DeletedAt{}},
-	{4, "lengzi", "zileng", "lengzi", 4, 4, "avatar_4", "background_image_4", "signature_4", 4, 4, 4, gorm.DeletedAt{}},
-	{5, "aniu", "niua", "aniu", 5, 5, "avatar_5", "background_image_5", "signature_5", 5, 5, 5, gorm.DeletedAt{}},
-	{6, "erlengzi", "zilenger", "erlengzi", 6, 6, "avatar_6", "background_image_6", "signature_6", 6, 6, 6, gorm.DeletedAt{}},
+	{1, "xiaoming", "mingxiao", "xiaoming", 1, 1, "avatar_1", "background_image_1", "signature_1", 1, 1, 1, gorm.DeletedAt{}, 0, false},
+	{2, "xiaohong", "hongxiao", "xiaohong", 2, 2, "avatar_2", "background_image_2", "signature_2", 2, 2, 2, gorm.DeletedAt{}, 0, false},
+	{3, "liuzi", "ziliu", "liuzi", 3, 3, "avatar_3", "background_image_3", "signature_3", 3, 3, 3, gorm.DeletedAt{}, 0, false},
+	{4, "lengzi", "zileng", "lengzi", 4, 4, "avatar_4", "background_image_4", "signature_4", 4, 4, 4, gorm.DeletedAt{}, 0, false},
+	{5, "aniu", "niua", "aniu", 5, 5, "avatar_5", "background_image_5", "signature_5", 5, 5, 5, gorm.DeletedAt{}, 0, false},
+	{6, "erlengzi", "zilenger", "erlengzi", 6, 6, "avatar_6", "background_image_6", "signature_6", 6, 6, 6, gorm.DeletedAt{}, 0, false},
 }
 
 type userRepo struct{}
@@ -91,6 +93,33 @@ func (r *userRepo) FindKeyInfoByUsername(ctx context.Context, username string) (
 	return nil, errors.New("user not found by username")
 }
 
+func (r *userRepo) FindSettingsById(ctx context.Context, uid uint32) (*biz.Settings, error) {
+	for i := range userTable {
+		if uid == userTable[i].Id {
+			return &biz.Settings{
+				FavoriteVisibility: userTable[i].FavoriteVisibility,
+				IsPrivate:          userTable[i].IsPrivate,
+			}, nil
+		}
+	}
+	return nil, biz.ErrUserNotFound
+}
+
+func (r *userRepo) UpdateSettings(ctx context.Context, uid uint32, settings *biz.SettingsUpdate) error {
+	for i := range userTable {
+		if uid == userTable[i].Id {
+			if settings.FavoriteVisibility != nil {
+				userTable[i].FavoriteVisibility = *settings.FavoriteVisibility
+			}
+			if settings.IsPrivate != nil {
+				userTable[i].IsPrivate = *settings.IsPrivate
+			}
+			return nil
+		}
+	}
+	return biz.ErrUserNotFound
+}
+
 func (r *userRepo) RunUpdateFollowListener() {
 	// update follow codes
 }
@@ -90,9 +90,33 @@ func testFindByUsername(t *testing.T) {
 	}
 }
 
+func testSettings(t *testing.T) {
+	ctx := context.Background()
+	settings, err := userRepo.FindSettingsById(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, biz.FavoritePublic, settings.FavoriteVisibility)
+	assert.False(t, settings.IsPrivate)
+	visibility, isPrivate := biz.FavoritePrivate, true
+	err = userRepo.UpdateSettings(ctx, 1, &biz.SettingsUpdate{FavoriteVisibility: &visibility})
+	assert.NoError(t, err)
+	settings, err = userRepo.FindSettingsById(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, biz.FavoritePrivate, settings.FavoriteVisibility)
+	assert.False(t, settings.IsPrivate)
+	err = userRepo.UpdateSettings(ctx, 1, &biz.SettingsUpdate{IsPrivate: &isPrivate})
+	assert.NoError(t, err)
+	settings, err = userRepo.FindSettingsById(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, biz.FavoritePrivate, settings.FavoriteVisibility)
+	assert.True(t, settings.IsPrivate)
+	_, err = userRepo.FindSettingsById(ctx, 100)
+	assert.ErrorIs(t, err, biz.ErrUserNotFound)
+}
+
 func TestUserRepo(t *testing.T) {
 	t.Run("TestUserRepoCreateUser", testCreate)
 	t.Run("TestUserRepoFindUserById", testFindById)
 	t.Run("TestUserRepoFindUsersByIds", testFindByIds)
 	t.Run("TestUserRepoFindUserByUsername", testFindByUsername)
+	t.Run("TestUserRepoSettings", testSettings)
 }
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jinzhu/copier"
+
+	"github.com/toomanysource/atreus/middleware"
+
+	pb "github.com/toomanysource/atreus/api/user/service/v1"
+)
+
+func (s *UserService) RegisterPublicKey(
+	ctx context.Context, req *pb.RegisterPublicKeyRequest,
+) (*pb.RegisterPublicKeyReply, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	key, err := s.ku.RegisterKey(ctx, userId, req.PublicKey)
+	if err != nil {
+		return &pb.RegisterPublicKeyReply{
+			StatusCode: CodeFailed,
+			StatusMsg:  err.Error(),
+		}, nil
+	}
+	reply := &pb.RegisterPublicKeyReply{
+		StatusCode: CodeSuccess,
+		StatusMsg:  "success",
+		Key:        new(pb.PublicKey),
+	}
+	copier.Copy(reply.Key, key)
+	return reply, nil
+}
+
+func (s *UserService) GetPublicKey(ctx context.Context, req *pb.PublicKeyRequest) (*pb.PublicKeyReply, error) {
+	key, err := s.ku.GetCurrentKey(ctx, req.UserId)
+	if err != nil {
+		return &pb.PublicKeyReply{
+			StatusCode: CodeFailed,
+			StatusMsg:  err.Error(),
+		}, nil
+	}
+	reply := &pb.PublicKeyReply{
+		StatusCode: CodeSuccess,
+		StatusMsg:  "success",
+		Key:        new(pb.PublicKey),
+	}
+	copier.Copy(reply.Key, key)
+	return reply, nil
+}
+
+func (s *UserService) GetPublicKeys(ctx context.Context, req *pb.PublicKeysRequest) (*pb.PublicKeysReply, error) {
+	keys, err := s.ku.GetKeys(ctx, req.KeyIds)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.PublicKeysReply{
+		Keys: make([]*pb.PublicKey, 0, len(keys)),
+	}
+	copier.Copy(&reply.Keys, &keys)
+	return reply, nil
+}
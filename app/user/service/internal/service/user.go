@@ -6,6 +6,7 @@ import (
 	"github.com/jinzhu/copier"
 
 	"github.com/toomanysource/atreus/app/user/service/internal/biz"
+	"github.com/toomanysource/atreus/middleware"
 
 	"github.com/go-kratos/kratos/v2/log"
 
@@ -23,10 +24,11 @@ type UserService struct {
 	log *log.Helper
 
 	uc *biz.UserUsecase
+	ku *biz.KeyUsecase
 }
 
-func NewUserService(uc *biz.UserUsecase, logger log.Logger) *UserService {
-	return &UserService{uc: uc, log: log.NewHelper(logger)}
+func NewUserService(uc *biz.UserUsecase, ku *biz.KeyUsecase, logger log.Logger) *UserService {
+	return &UserService{uc: uc, ku: ku, log: log.NewHelper(logger)}
 }
 
 func (s *UserService) UserRegister(ctx context.Context, req *pb.UserRegisterRequest) (*pb.UserRegisterReply, error) {
@@ -91,3 +93,35 @@ func (s *UserService) GetUserInfos(ctx context.Context, req *pb.UserInfosRequest
 	copier.Copy(&reply.Users, &users)
 	return reply, nil
 }
+
+func (s *UserService) UpdateUserSettings(
+	ctx context.Context, req *pb.UpdateUserSettingsRequest,
+) (*pb.UpdateUserSettingsReply, error) {
+	userId := ctx.Value(middleware.UserIdKey("user_id")).(uint32)
+	err := s.uc.UpdateSettings(ctx, userId, &biz.SettingsUpdate{
+		FavoriteVisibility: req.FavoriteVisibility,
+		IsPrivate:          req.IsPrivate,
+	})
+	if err != nil {
+		return &pb.UpdateUserSettingsReply{
+			StatusCode: CodeFailed,
+			StatusMsg:  err.Error(),
+		}, nil
+	}
+	return &pb.UpdateUserSettingsReply{
+		StatusCode: CodeSuccess,
+		StatusMsg:  "success",
+	}, nil
+}
+
+func (s *UserService) GetUserSettings(ctx context.Context, req *pb.UserSettingsRequest) (*pb.UserSettingsReply, error) {
+	settings, err := s.uc.GetSettings(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.UserSettingsReply{
+		Settings: new(pb.UserSettings),
+	}
+	copier.Copy(reply.Settings, settings)
+	return reply, nil
+}
@@ -30,17 +30,26 @@ func TokenParseAll(keyFunc jwt.Keyfunc) middleware.Middleware {
 							return handler(ctx, req)
 						}
 					}
-					token, err := jwt.Parse(tokenString, keyFunc)
+					userId, err := ParseToken(tokenString, keyFunc)
 					if err != nil {
-						return nil, New(-1, err.Error())
+						return nil, err
 					}
-					if !token.Valid {
-						return nil, New(-1, "token is invalid")
-					}
-					ctx = context.WithValue(ctx, key, uint32(token.Claims.(jwt.MapClaims)["user_id"].(float64)))
+					ctx = context.WithValue(ctx, key, userId)
 				}
 			}
 			return handler(ctx, req)
 		}
 	}
 }
+
+// ParseToken 解析token并返回其中的用户id，供不经过中间件的处理函数鉴权使用
+func ParseToken(tokenString string, keyFunc jwt.Keyfunc) (uint32, error) {
+	token, err := jwt.Parse(tokenString, keyFunc)
+	if err != nil {
+		return 0, New(-1, err.Error())
+	}
+	if !token.Valid {
+		return 0, New(-1, "token is invalid")
+	}
+	return uint32(token.Claims.(jwt.MapClaims)["user_id"].(float64)), nil
+}
@@ -0,0 +1,68 @@
+package cmdX
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/toomanysource/atreus/pkg/logX"
+)
+
+// NewLogger 创建维护命令使用的日志，输出到标准输出
+func NewLogger(module string) (*logX.Log, *log.Helper) {
+	l := logX.NewDefaultLogger()
+	l.SetOutput(os.Stdout)
+	l.SetLevel(log.LevelInfo)
+	return l, log.NewHelper(log.With(l, "module", module))
+}
+
+// LoadConfig 加载path下的配置文件并解析到bc
+func LoadConfig(path string, bc interface{}) error {
+	c := config.New(
+		config.WithSource(
+			file.NewSource(path),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		return err
+	}
+	return c.Scan(bc)
+}
+
+// OpenDB 连接数据库，只输出警告级别以上的日志
+func OpenDB(dsn string) (*gorm.DB, error) {
+	return gorm.Open(mysql.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Warn),
+	})
+}
+
+// Run 执行一次task，interval大于0时按间隔定期执行，收到中断信号后退出
+func Run(interval time.Duration, task func(ctx context.Context)) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	task(ctx)
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			task(ctx)
+		}
+	}
+}
@@ -15,6 +15,7 @@ var (
 	ErrImageGenerate = errors.New("image generate error")
 	ErrImageDecode   = errors.New("image decode error")
 	ErrImageSave     = errors.New("image save error")
+	ErrImageEncode   = errors.New("image encode error")
 )
 
 // ReadFrameAsImage 读取视频文件的某一帧并转换为jpeg格式
@@ -46,3 +47,19 @@ func SaveImage(reader io.Reader, outFilePath string) error {
 	}
 	return err
 }
+
+// GenerateThumbnail 按比例缩放图片并转换为jpeg格式的缩略图
+// reader: 输入的图片流
+// width: 缩略图最大宽度
+// height: 缩略图最大高度
+func GenerateThumbnail(reader io.Reader, width, height int) (io.Reader, error) {
+	img, err := imaging.Decode(reader, imaging.AutoOrientation(true))
+	if err != nil {
+		return nil, errors.Join(ErrImageDecode, err)
+	}
+	buf := bytes.NewBuffer(nil)
+	if err = imaging.Encode(buf, imaging.Fit(img, width, height, imaging.Lanczos), imaging.JPEG); err != nil {
+		return nil, errors.Join(ErrImageEncode, err)
+	}
+	return buf, nil
+}
@@ -1,8 +1,12 @@
 package ffmpegX
 
 import (
+	"bytes"
+	"image"
+	"image/png"
 	"testing"
 
+	"github.com/disintegration/imaging"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -16,3 +20,15 @@ func TestSaveImage(t *testing.T) {
 	err := SaveImage(reader, "./test1.jpg")
 	assert.Nil(t, err)
 }
+
+func TestGenerateThumbnail(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	err := png.Encode(buf, image.NewRGBA(image.Rect(0, 0, 800, 400)))
+	assert.Nil(t, err)
+	reader, err := GenerateThumbnail(buf, 200, 200)
+	assert.Nil(t, err)
+	img, err := imaging.Decode(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, 200, img.Bounds().Dx())
+	assert.Equal(t, 100, img.Bounds().Dy())
+}
@@ -6,17 +6,25 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/segmentio/kafka-go"
 )
 
-// Reader 消费者循环
+// 消息处理失败后重试同一条消息的退避时间
+const (
+	retryMinBackoff = 100 * time.Millisecond
+	retryMaxBackoff = 30 * time.Second
+)
+
+// Reader 消费者循环，消息处理成功后才提交偏移量，处理失败时按指数退避重试同一条消息，
+// 处理函数需要保证重复处理是安全的，无法处理的消息应记录日志后返回nil，否则会一直重试
 // reader 消费者队列
 // log 日志
 // f 消息处理函数
 func Reader(reader *kafka.Reader, log *log.Helper,
-	f func(ctx context.Context, reader *kafka.Reader, msg kafka.Message),
+	f func(ctx context.Context, reader *kafka.Reader, msg kafka.Message) error,
 ) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -32,7 +40,8 @@ func Reader(reader *kafka.Reader, log *log.Helper,
 		case <-ctx.Done():
 			return
 		default:
-			msg, err := reader.ReadMessage(ctx)
+			// ReadMessage在消费者组中会自动提交偏移量，使用FetchMessage在处理成功后手动提交
+			msg, err := reader.FetchMessage(ctx)
 			if errors.Is(err, context.Canceled) {
 				return
 			}
@@ -40,7 +49,11 @@ func Reader(reader *kafka.Reader, log *log.Helper,
 				log.Errorf("read message error, err: %v", err)
 				return
 			}
-			f(ctx, reader, msg)
+			if err = handle(ctx, log, msg, func(ctx context.Context, msg kafka.Message) error {
+				return f(ctx, reader, msg)
+			}); err != nil {
+				return
+			}
 			err = reader.CommitMessages(ctx, msg)
 			if err != nil {
 				log.Errorf("commit message error, err: %v", err)
@@ -50,3 +63,25 @@ func Reader(reader *kafka.Reader, log *log.Helper,
 		}
 	}
 }
+
+// handle 处理消息直到成功，失败时按指数退避重试，ctx结束时返回ctx的错误
+func handle(ctx context.Context, log *log.Helper, msg kafka.Message,
+	f func(ctx context.Context, msg kafka.Message) error,
+) error {
+	backoff := retryMinBackoff
+	for {
+		err := f(ctx, msg)
+		if err == nil {
+			return nil
+		}
+		log.Errorf("handle message error, retry in %v, %v-(%v), err: %v", backoff, string(msg.Key), string(msg.Value), err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > retryMaxBackoff {
+			backoff = retryMaxBackoff
+		}
+	}
+}
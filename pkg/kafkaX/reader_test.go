@@ -0,0 +1,39 @@
+package kafkaX
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandle(t *testing.T) {
+	logger := log.NewHelper(log.DefaultLogger)
+	msg := kafka.Message{Key: []byte("key"), Value: []byte("value")}
+
+	// 失败后重试同一条消息直到成功
+	attempts := 0
+	err := handle(context.Background(), logger, msg, func(ctx context.Context, m kafka.Message) error {
+		assert.Equal(t, msg, m)
+		if attempts++; attempts < 3 {
+			return errors.New("insert failed")
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, attempts)
+
+	// ctx结束时停止重试，返回错误使消息不被提交
+	ctx, cancel := context.WithTimeout(context.Background(), retryMinBackoff/2)
+	defer cancel()
+	attempts = 0
+	err = handle(ctx, logger, msg, func(ctx context.Context, m kafka.Message) error {
+		attempts++
+		return errors.New("insert failed")
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, attempts)
+}
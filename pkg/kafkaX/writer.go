@@ -12,7 +12,12 @@ import (
 var ErrKafkaWriter = errors.New("kafka writer error")
 
 func Update(writer *kafka.Writer, key, value string) error {
-	err := writer.WriteMessages(context.TODO(),
+	return UpdateContext(context.TODO(), writer, key, value)
+}
+
+// UpdateContext 同步写入一条消息，writer未开启Async时返回即表示Kafka已按RequiredAcks确认
+func UpdateContext(ctx context.Context, writer *kafka.Writer, key, value string) error {
+	err := writer.WriteMessages(ctx,
 		kafka.Message{
 			Partition: 0,
 			Key:       []byte(key),
@@ -15,6 +15,7 @@ var (
 	ErrMinioServer = errors.New("minio server error")
 	ErrFileUpload  = errors.New("file upload error")
 	ErrGetFileURL  = errors.New("get file url error")
+	ErrFileRemove  = errors.New("file remove error")
 )
 
 // ExtraConn 外网连接返回文件Url
@@ -93,6 +94,15 @@ func (c *Client) UploadSizeFile(
 	return nil
 }
 
+// RemoveFile 从minio删除文件，文件不存在时视为成功
+func (c *Client) RemoveFile(ctx context.Context, bucketName string, fileName string) error {
+	err := c.intraConn.conn.RemoveObject(ctx, bucketName, fileName, minio.RemoveObjectOptions{})
+	if err != nil {
+		return errors.Join(ErrFileRemove, err)
+	}
+	return nil
+}
+
 // GetFileURL 根据文件名从minio获取文件URL
 func (c *Client) GetFileURL(ctx context.Context, bucketName string, fileName string, timeLimit time.Duration) (*url.URL, error) {
 	reqParams := make(url.Values)
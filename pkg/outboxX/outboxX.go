@@ -0,0 +1,256 @@
+package outboxX
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/segmentio/kafka-go"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	StatusPending uint8 = iota // 待发送
+	StatusSent                 // 已发送
+)
+
+const (
+	DefaultInterval  = time.Second     // 轮询发件箱的间隔
+	DefaultBatchSize = 100             // 每次轮询发送的最大消息数
+	SentRetention    = 24 * time.Hour  // 已发送消息的保留时间
+	MaxRetryDelay    = 5 * time.Minute // 发送失败后重试的最大间隔
+)
+
+var (
+	ErrOutboxInsert = errors.New("outbox insert error")
+	ErrOutboxQuery  = errors.New("outbox query error")
+	ErrOutboxUpdate = errors.New("outbox update error")
+	ErrOutboxDelete = errors.New("outbox delete error")
+)
+
+// Message 发件箱消息，与业务数据在同一个数据库事务中写入，由Relay异步投递到Kafka
+type Message struct {
+	Id         uint64 `gorm:"primary_key"`
+	Topic      string `gorm:"column:topic;size:128;not null;index:idx_status_topic,priority:2"`
+	Key        string `gorm:"column:msg_key;size:128;not null"`
+	Value      string `gorm:"column:msg_value;type:text;not null"`
+	Status     uint8  `gorm:"column:status;not null;default:0;index:idx_status_topic,priority:1"`
+	Attempts   uint32 `gorm:"column:attempts;not null;default:0"`
+	CreateTime int64  `gorm:"column:create_time;not null;default:0"`
+	SentTime   int64  `gorm:"column:sent_time;not null;default:0"`
+	// 发送失败后下一次重试的时间，重试前不会被领取，避免持续失败的消息阻塞其他消息
+	NextAttemptTime int64 `gorm:"column:next_attempt_time;not null;default:0"`
+}
+
+func (Message) TableName() string {
+	return "outbox_messages"
+}
+
+// InitDB 创建outbox_messages数据表，并自动迁移
+func InitDB(db *gorm.DB) error {
+	return db.AutoMigrate(&Message{})
+}
+
+// Add 在事务tx中写入一条待发送的消息，tx提交后消息才对Relay可见
+func Add(tx *gorm.DB, topic, key, value string) error {
+	err := tx.Model(&Message{}).Create(&Message{
+		Topic:      topic,
+		Key:        key,
+		Value:      value,
+		Status:     StatusPending,
+		CreateTime: time.Now().Unix(),
+	}).Error
+	if err != nil {
+		return errors.Join(ErrOutboxInsert, err)
+	}
+	return nil
+}
+
+// messageWriter 向一个topic写入消息
+type messageWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+}
+
+// Relay 轮询发件箱并将待发送的消息投递到Kafka，投递失败的消息按指数退避延后重试
+type Relay struct {
+	db      *gorm.DB
+	writers map[string]messageWriter
+	topics  []string
+	log     *log.Helper
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// NewRelay 创建发件箱投递器，只投递writers对应topic的消息
+func NewRelay(db *gorm.DB, logger log.Logger, writers ...*kafka.Writer) *Relay {
+	r := &Relay{
+		db:      db,
+		writers: make(map[string]messageWriter, len(writers)),
+		topics:  make([]string, 0, len(writers)),
+		log:     log.NewHelper(log.With(logger, "module", "pkg/outboxX")),
+	}
+	for _, w := range writers {
+		r.writers[w.Topic] = w
+		r.topics = append(r.topics, w.Topic)
+	}
+	return r
+}
+
+// Start 启动后台投递循环
+func (r *Relay) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(DefaultInterval)
+		defer ticker.Stop()
+		purge := time.NewTicker(time.Hour)
+		defer purge.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				// 一次轮询发满一批时说明可能还有积压，继续发送
+				for {
+					n, err := r.Flush(ctx)
+					if err != nil {
+						r.log.Errorf("flush outbox error, err: %v", err)
+						break
+					}
+					if n < DefaultBatchSize {
+						break
+					}
+				}
+			case <-purge.C:
+				if err := r.Purge(ctx, time.Now().Add(-SentRetention)); err != nil {
+					r.log.Errorf("purge outbox error, err: %v", err)
+				}
+			}
+		}
+	}()
+	r.log.Infof("outbox relay started, topics: %v", r.topics)
+}
+
+// Stop 停止后台投递循环，需要在关闭Kafka连接之前调用
+func (r *Relay) Stop() {
+	if r.cancel == nil {
+		return
+	}
+	r.cancel()
+	r.wg.Wait()
+	r.log.Info("outbox relay stopped")
+}
+
+// Flush 发送一批到达重试时间的待发送消息，返回成功发送的消息数。
+// 消息在行锁内发送并标记，多个实例同时运行时不会重复领取同一条消息；
+// 发送失败的消息逐条延后重试，不影响其他消息和其他topic；
+// 投递到Kafka成功但标记失败时消息会被重新发送，消费方需要容忍重复消息
+func (r *Relay) Flush(ctx context.Context) (int, error) {
+	if len(r.topics) == 0 {
+		return 0, nil
+	}
+	sent := 0
+	now := time.Now()
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var msgs []*Message
+		err := tx.Model(&Message{}).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND topic IN ? AND next_attempt_time <= ?", StatusPending, r.topics, now.Unix()).
+			Order("id").Limit(DefaultBatchSize).Find(&msgs).Error
+		if err != nil {
+			return errors.Join(ErrOutboxQuery, err)
+		}
+		if len(msgs) == 0 {
+			return nil
+		}
+		// 按topic分组，同一topic的消息按写入顺序一次性发送
+		groups := make(map[string][]*Message, len(r.topics))
+		for _, m := range msgs {
+			groups[m.Topic] = append(groups[m.Topic], m)
+		}
+		var sentIds []uint64
+		var failed []*Message
+		for topic, ms := range groups {
+			km := make([]kafka.Message, 0, len(ms))
+			for _, m := range ms {
+				km = append(km, kafka.Message{
+					Partition: 0,
+					Key:       []byte(m.Key),
+					Value:     []byte(m.Value),
+				})
+			}
+			err = r.writers[topic].WriteMessages(ctx, km...)
+			if err != nil {
+				r.log.Errorf("relay outbox message error, topic: %v, err: %v", topic, err)
+			}
+			// 部分消息写入失败时只重试失败的消息
+			var writeErrs kafka.WriteErrors
+			partial := errors.As(err, &writeErrs) && len(writeErrs) == len(ms)
+			for i, m := range ms {
+				if err == nil || (partial && writeErrs[i] == nil) {
+					sentIds = append(sentIds, m.Id)
+				} else {
+					failed = append(failed, m)
+				}
+			}
+		}
+		if len(sentIds) != 0 {
+			err = tx.Model(&Message{}).Where("id IN ?", sentIds).Updates(map[string]interface{}{
+				"status":    StatusSent,
+				"attempts":  gorm.Expr("attempts + 1"),
+				"sent_time": time.Now().Unix(),
+			}).Error
+			if err != nil {
+				return errors.Join(ErrOutboxUpdate, err)
+			}
+		}
+		// 相同重试次数的消息延后到同一时间
+		retries := make(map[uint32][]uint64)
+		for _, m := range failed {
+			retries[m.Attempts+1] = append(retries[m.Attempts+1], m.Id)
+		}
+		for attempts, ids := range retries {
+			err = tx.Model(&Message{}).Where("id IN ?", ids).Updates(map[string]interface{}{
+				"attempts":          attempts,
+				"next_attempt_time": now.Add(RetryDelay(attempts)).Unix(),
+			}).Error
+			if err != nil {
+				return errors.Join(ErrOutboxUpdate, err)
+			}
+		}
+		sent = len(sentIds)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return sent, nil
+}
+
+// RetryDelay 第attempts次发送失败后到下一次重试的间隔，从DefaultInterval开始每次翻倍，不超过MaxRetryDelay
+func RetryDelay(attempts uint32) time.Duration {
+	delay := DefaultInterval
+	for i := uint32(1); i < attempts && delay < MaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > MaxRetryDelay {
+		delay = MaxRetryDelay
+	}
+	return delay
+}
+
+// Purge 删除before之前已发送的消息
+func (r *Relay) Purge(ctx context.Context, before time.Time) error {
+	err := r.db.WithContext(ctx).Model(&Message{}).
+		Where("status = ? AND topic IN ? AND sent_time < ?", StatusSent, r.topics, before.Unix()).
+		Delete(&Message{}).Error
+	if err != nil {
+		return errors.Join(ErrOutboxDelete, err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package outboxX
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/toomanysource/atreus/pkg/sqlmockX"
+)
+
+// mockWriter 写入时返回err，err为kafka.WriteErrors时只有对应位置的消息失败
+type mockWriter struct {
+	err     error
+	written []kafka.Message
+}
+
+func (w *mockWriter) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	w.written = append(w.written, msgs...)
+	return w.err
+}
+
+func TestRelay_Flush(t *testing.T) {
+	ctx := context.Background()
+	db, mock := sqlmockX.New(t)
+	healthy := &mockWriter{}
+	broken := &mockWriter{err: errors.New("broken")}
+	partial := &mockWriter{err: kafka.WriteErrors{nil, errors.New("too large")}}
+	r := &Relay{
+		db:      db,
+		writers: map[string]messageWriter{"healthy": healthy, "broken": broken, "partial": partial},
+		topics:  []string{"healthy", "broken", "partial"},
+		log:     log.NewHelper(log.DefaultLogger),
+	}
+	mock.ExpectQuery("FROM `outbox_messages`", &sqlmockX.Rows{
+		Columns: []string{"id", "topic", "msg_key", "msg_value", "attempts"},
+		Values: [][]driver.Value{
+			{int64(1), "broken", "k", "v", int64(3)},
+			{int64(2), "healthy", "k", "v", int64(0)},
+			{int64(3), "partial", "k", "v", int64(0)},
+			{int64(4), "partial", "k", "v", int64(0)},
+		},
+	})
+
+	before := time.Now()
+	sent, err := r.Flush(ctx)
+	assert.Nil(t, err)
+	// 持续失败的topic不影响其他topic
+	assert.Equal(t, 2, sent)
+	assert.Equal(t, 1, len(healthy.written))
+	selects := mock.Selects("outbox_messages")
+	assert.Equal(t, 1, len(selects))
+	assert.Contains(t, selects[0].SQL, "next_attempt_time <= ?")
+
+	var sentUpdate map[string]driver.Value
+	retries := make(map[int64]int64)
+	for _, u := range mock.Updates("outbox_messages") {
+		if _, ok := u["status"]; ok {
+			sentUpdate = u
+			continue
+		}
+		retries[u["attempts"].(int64)] = u["next_attempt_time"].(int64)
+	}
+	assert.Equal(t, int64(StatusSent), sentUpdate["status"])
+	// 失败的消息按各自的失败次数延后重试
+	assert.Equal(t, 2, len(retries))
+	assert.GreaterOrEqual(t, retries[1], before.Add(RetryDelay(1)).Unix())
+	assert.GreaterOrEqual(t, retries[4], before.Add(RetryDelay(4)).Unix())
+	assert.Greater(t, retries[4], retries[1])
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, DefaultInterval, RetryDelay(1))
+	assert.Equal(t, 8*DefaultInterval, RetryDelay(4))
+	assert.Equal(t, MaxRetryDelay, RetryDelay(100))
+}
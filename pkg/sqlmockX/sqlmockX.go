@@ -0,0 +1,295 @@
+package sqlmockX
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var ErrNotSupported = errors.New("sqlmockX: prepared statements are not supported")
+
+// Statement 执行过的语句和参数
+type Statement struct {
+	SQL  string
+	Args []driver.Value
+}
+
+// Locked 语句是否为加锁读
+func (s Statement) Locked() bool {
+	return strings.HasSuffix(s.SQL, "FOR UPDATE")
+}
+
+// Rows 查询返回的预设结果集
+type Rows struct {
+	Columns []string
+	Values  [][]driver.Value
+}
+
+type expect struct {
+	substr       string
+	rows         *Rows
+	rowsAffected int64
+	err          error
+}
+
+// Mock 不连接数据库的gorm连接，记录执行过的语句，按注册顺序匹配包含指定片段的语句返回预设结果，
+// 用于测试数据层读写了哪些表和列。未匹配的查询返回空结果集，未匹配的修改语句影响1行
+type Mock struct {
+	mu      sync.Mutex
+	queries []*expect
+	execs   []*expect
+	history []Statement
+	lastId  int64
+}
+
+// New 返回使用mysql方言的gorm连接和对应的Mock，连接没有预设表，未指定Model的语句会失败
+func New(tb testing.TB) (*gorm.DB, *Mock) {
+	tb.Helper()
+	m := &Mock{}
+	db, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sql.OpenDB(m),
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return db, m
+}
+
+// ExpectQuery 包含substr的查询返回rows
+func (m *Mock) ExpectQuery(substr string, rows *Rows) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.queries = append(m.queries, &expect{substr: substr, rows: rows})
+}
+
+// ExpectExec 包含substr的修改语句影响rowsAffected行
+func (m *Mock) ExpectExec(substr string, rowsAffected int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.execs = append(m.execs, &expect{substr: substr, rowsAffected: rowsAffected})
+}
+
+// ExpectError 包含substr的查询和修改语句返回err
+func (m *Mock) ExpectError(substr string, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e := &expect{substr: substr, err: err}
+	m.queries = append(m.queries, e)
+	m.execs = append(m.execs, e)
+}
+
+// History 按执行顺序返回执行过的语句
+func (m *Mock) History() []Statement {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Statement(nil), m.history...)
+}
+
+// Selects 返回查询table的语句，包括手写的原生查询
+func (m *Mock) Selects(table string) []Statement {
+	from := regexp.MustCompile("FROM `?" + regexp.QuoteMeta(table) + "`?(\\s|$)")
+	var found []Statement
+	for _, s := range m.filter("SELECT ", "") {
+		if from.MatchString(s.SQL) {
+			found = append(found, s)
+		}
+	}
+	return found
+}
+
+// Deletes 返回删除table中记录的语句
+func (m *Mock) Deletes(table string) []Statement {
+	return m.filter("DELETE FROM `"+table+"`", "")
+}
+
+// Inserts 返回写入table的每一行，key为列名
+func (m *Mock) Inserts(table string) []map[string]driver.Value {
+	var rows []map[string]driver.Value
+	for _, s := range m.filter("INSERT INTO `"+table+"`", "") {
+		start, end := strings.Index(s.SQL, "("), strings.Index(s.SQL, ")")
+		columns := columnPattern.FindAllStringSubmatch(s.SQL[start:end], -1)
+		for i := 0; i+len(columns) <= len(s.Args); i += len(columns) {
+			row := make(map[string]driver.Value, len(columns))
+			for j, c := range columns {
+				row[c[1]] = s.Args[i+j]
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// Updates 返回更新table的语句设置的列和值，值不是参数的列为对应的SQL表达式
+func (m *Mock) Updates(table string) []map[string]driver.Value {
+	var updates []map[string]driver.Value
+	for _, s := range m.filter("UPDATE `"+table+"` SET ", "") {
+		set := strings.TrimPrefix(s.SQL, "UPDATE `"+table+"` SET ")
+		if i := strings.Index(set, " WHERE "); i >= 0 {
+			set = set[:i]
+		}
+		values := make(map[string]driver.Value)
+		locs := assignPattern.FindAllStringSubmatchIndex(set, -1)
+		arg := 0
+		for i, loc := range locs {
+			end := len(set)
+			if i+1 < len(locs) {
+				end = locs[i+1][0] - 1
+			}
+			column, expr := set[loc[2]:loc[3]], set[loc[1]:end]
+			if expr == "?" && arg < len(s.Args) {
+				values[column] = s.Args[arg]
+			} else {
+				values[column] = expr
+			}
+			arg += strings.Count(expr, "?")
+		}
+		updates = append(updates, values)
+	}
+	return updates
+}
+
+var (
+	columnPattern = regexp.MustCompile("`(\\w+)`")
+	assignPattern = regexp.MustCompile("`(\\w+)`=")
+)
+
+func (m *Mock) filter(prefix, substr string) []Statement {
+	var found []Statement
+	for _, s := range m.History() {
+		if strings.HasPrefix(s.SQL, prefix) && strings.Contains(s.SQL, substr) {
+			found = append(found, s)
+		}
+	}
+	return found
+}
+
+func (m *Mock) nextId() int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lastId++
+	return m.lastId
+}
+
+func (m *Mock) record(query string, args []driver.NamedValue, expects []*expect) *expect {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	values := make([]driver.Value, 0, len(args))
+	for _, a := range args {
+		values = append(values, a.Value)
+	}
+	m.history = append(m.history, Statement{SQL: query, Args: values})
+	for _, e := range expects {
+		if strings.Contains(query, e.substr) {
+			return e
+		}
+	}
+	return nil
+}
+
+func (m *Mock) Connect(context.Context) (driver.Conn, error) {
+	return &conn{m: m}, nil
+}
+
+func (m *Mock) Driver() driver.Driver {
+	return m
+}
+
+func (m *Mock) Open(string) (driver.Conn, error) {
+	return &conn{m: m}, nil
+}
+
+type conn struct {
+	m *Mock
+}
+
+func (c *conn) Prepare(string) (driver.Stmt, error) {
+	return nil, ErrNotSupported
+}
+
+func (c *conn) Close() error {
+	return nil
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return c, nil
+}
+
+func (c *conn) Commit() error {
+	return nil
+}
+
+func (c *conn) Rollback() error {
+	return nil
+}
+
+func (c *conn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	e := c.m.record(query, args, c.m.execs)
+	if e == nil {
+		return &result{lastId: c.m.nextId(), rowsAffected: 1}, nil
+	}
+	if e.err != nil {
+		return nil, e.err
+	}
+	return &result{lastId: c.m.nextId(), rowsAffected: e.rowsAffected}, nil
+}
+
+func (c *conn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	e := c.m.record(query, args, c.m.queries)
+	if e != nil && e.err != nil {
+		return nil, e.err
+	}
+	if e == nil || e.rows == nil {
+		return &rows{}, nil
+	}
+	return &rows{Rows: e.rows}, nil
+}
+
+// result 自增id从1开始依次递增
+type result struct {
+	lastId       int64
+	rowsAffected int64
+}
+
+func (r *result) LastInsertId() (int64, error) {
+	return r.lastId, nil
+}
+
+func (r *result) RowsAffected() (int64, error) {
+	return r.rowsAffected, nil
+}
+
+type rows struct {
+	*Rows
+	next int
+}
+
+func (r *rows) Columns() []string {
+	if r.Rows == nil {
+		return nil
+	}
+	return r.Rows.Columns
+}
+
+func (r *rows) Close() error {
+	return nil
+}
+
+func (r *rows) Next(dest []driver.Value) error {
+	if r.Rows == nil || r.next >= len(r.Values) {
+		return io.EOF
+	}
+	copy(dest, r.Values[r.next])
+	r.next++
+	return nil
+}
@@ -0,0 +1,57 @@
+package sqlmockX
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type item struct {
+	Id    uint32
+	Name  string
+	Count uint32
+}
+
+func TestMock(t *testing.T) {
+	ctx := context.Background()
+	db, mock := New(t)
+	mock.ExpectQuery("FROM `items`", &Rows{
+		Columns: []string{"id", "name"},
+		Values:  [][]driver.Value{{int64(1), "a"}},
+	})
+	errBroken := errors.New("broken")
+	mock.ExpectError("DELETE FROM `items`", errBroken)
+
+	items := []*item{{Name: "a"}, {Name: "b", Count: 2}}
+	assert.Nil(t, db.WithContext(ctx).Create(&items).Error)
+	assert.Equal(t, []map[string]driver.Value{
+		{"name": "a", "count": int64(0)},
+		{"name": "b", "count": int64(2)},
+	}, mock.Inserts("items"))
+
+	err := db.WithContext(ctx).Model(&item{}).Where("id = ?", 1).Updates(map[string]interface{}{
+		"name": "c", "count": gorm.Expr("count + ?", 1),
+	}).Error
+	assert.Nil(t, err)
+	assert.Equal(t, []map[string]driver.Value{{"name": "c", "count": "count + ?"}}, mock.Updates("items"))
+
+	found := &item{}
+	err = db.WithContext(ctx).Model(&item{}).Clauses(clause.Locking{Strength: "UPDATE"}).Take(found).Error
+	assert.Nil(t, err)
+	assert.Equal(t, "a", found.Name)
+	selects := mock.Selects("items")
+	assert.Equal(t, 1, len(selects))
+	assert.True(t, selects[0].Locked())
+
+	assert.ErrorIs(t, db.WithContext(ctx).Delete(&item{}, 1).Error, errBroken)
+	assert.Equal(t, 1, len(mock.Deletes("items")))
+
+	// 没有预设表
+	var count int64
+	assert.NotNil(t, db.WithContext(ctx).Where("id = ?", 1).Count(&count).Error)
+}